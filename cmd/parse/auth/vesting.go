@@ -1,11 +1,18 @@
 package auth
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sort"
 
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
 	"github.com/forbole/juno/v5/types/config"
+	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 
 	"github.com/forbole/callisto/v4/database"
@@ -17,7 +24,7 @@ import (
 func vestingCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 	return &cobra.Command{
 		Use:   "vesting-accounts",
-		Short: "Fix the vesting accounts stored by removing duplicated vesting periods",
+		Short: "Fix the vesting accounts stored by removing duplicated vesting periods and parsing the ones created after genesis",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
 			if err != nil {
@@ -48,6 +55,73 @@ func vestingCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 				return fmt.Errorf("error while storing vesting accounts: %s", err)
 			}
 
+			// Build the auth module
+			authModule := authutils.NewModule(nil, nil, parseCtx.EncodingConfig.Codec, db)
+
+			// Collect all the transactions creating vesting accounts. Since the messages executed through authz
+			// are not indexed by their action, all the transactions executing authz messages are collected as well
+			var txs []*tmctypes.ResultTx
+			seen := map[string]bool{}
+			for _, msgType := range []string{
+				sdk.MsgTypeURL(&vestingtypes.MsgCreateVestingAccount{}),
+				sdk.MsgTypeURL(&vestingtypes.MsgCreatePeriodicVestingAccount{}),
+				sdk.MsgTypeURL(&vestingtypes.MsgCreatePermanentLockedAccount{}),
+				sdk.MsgTypeURL(&authz.MsgExec{}),
+			} {
+				query := fmt.Sprintf("message.action='%s'", msgType)
+				msgTxs, err := utils.QueryTxs(parseCtx.Node, query)
+				if err != nil {
+					return err
+				}
+
+				// Transactions containing different message types are returned by more than one query
+				for _, tx := range msgTxs {
+					if seen[tx.Hash.String()] {
+						continue
+					}
+					seen[tx.Hash.String()] = true
+					txs = append(txs, tx)
+				}
+			}
+
+			// Sort the txs based on their ascending height
+			sort.Slice(txs, func(i, j int) bool {
+				return txs[i].Height < txs[j].Height
+			})
+
+			for _, tx := range txs {
+				log.Debug().Int64("height", tx.Height).Msg("parsing transaction")
+				transaction, err := parseCtx.Node.Tx(hex.EncodeToString(tx.Tx.Hash()))
+				if err != nil {
+					return err
+				}
+
+				// Handle the vesting messages, including the ones executed through authz
+				for _, msg := range transaction.GetMsgs() {
+					err = authModule.HandleVestingMsg(msg, transaction)
+					if err != nil {
+						return fmt.Errorf("error while handling vesting message: %s", err)
+					}
+
+					execMsg, ok := msg.(*authz.MsgExec)
+					if !ok {
+						continue
+					}
+
+					executedMsgs, err := execMsg.GetMessages()
+					if err != nil {
+						return fmt.Errorf("error while getting executed messages: %s", err)
+					}
+
+					for _, executedMsg := range executedMsgs {
+						err = authModule.HandleVestingMsg(executedMsg, transaction)
+						if err != nil {
+							return fmt.Errorf("error while handling executed vesting message: %s", err)
+						}
+					}
+				}
+			}
+
 			return nil
 		},
 	}
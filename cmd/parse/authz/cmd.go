@@ -0,0 +1,20 @@
+package authz
+
+import (
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/spf13/cobra"
+)
+
+// NewAuthzCmd returns the Cobra command that allows to fix all the things related to the x/authz module
+func NewAuthzCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authz",
+		Short: "Fix things related to the x/authz module",
+	}
+
+	cmd.AddCommand(
+		grantsCmd(parseConfig),
+	)
+
+	return cmd
+}
@@ -0,0 +1,98 @@
+package authz
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+	"github.com/forbole/callisto/v4/modules/authz"
+	"github.com/forbole/callisto/v4/utils"
+)
+
+// grantsCmd returns the Cobra command allowing to fix all things related to authz grants
+func grantsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grants",
+		Short: "Fix granted, revoked and used authz grants to the latest height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build authz module
+			authzModule := authz.NewModule(parseCtx.EncodingConfig.Codec, db)
+
+			// Collect all the MsgGrant, MsgRevoke and MsgExec transactions
+			var txs []*tmctypes.ResultTx
+			for _, msgType := range []string{
+				sdk.MsgTypeURL(&authztypes.MsgGrant{}),
+				sdk.MsgTypeURL(&authztypes.MsgRevoke{}),
+				sdk.MsgTypeURL(&authztypes.MsgExec{}),
+			} {
+				query := fmt.Sprintf("message.action='%s'", msgType)
+				msgTxs, err := utils.QueryTxs(parseCtx.Node, query)
+				if err != nil {
+					return err
+				}
+				txs = append(txs, msgTxs...)
+			}
+
+			// Sort the txs based on their ascending height
+			sort.Slice(txs, func(i, j int) bool {
+				return txs[i].Height < txs[j].Height
+			})
+
+			for _, tx := range txs {
+				log.Debug().Int64("height", tx.Height).Msg("parsing transaction")
+				transaction, err := parseCtx.Node.Tx(hex.EncodeToString(tx.Tx.Hash()))
+				if err != nil {
+					return err
+				}
+
+				for index, msg := range transaction.GetMsgs() {
+					msgExec, isMsgExec := msg.(*authztypes.MsgExec)
+					if !isMsgExec {
+						err = authzModule.HandleMsg(index, msg, transaction)
+						if err != nil {
+							return fmt.Errorf("error while handling authz module message: %s", err)
+						}
+						continue
+					}
+
+					executedMsgs, err := msgExec.GetMessages()
+					if err != nil {
+						return fmt.Errorf("error while unpacking MsgExec messages: %s", err)
+					}
+
+					for authzIndex, executedMsg := range executedMsgs {
+						err = authzModule.HandleMsgExec(index, msgExec, authzIndex, executedMsg, transaction)
+						if err != nil {
+							return fmt.Errorf("error while handling authz module MsgExec: %s", err)
+						}
+					}
+				}
+			}
+
+			// Remove the grants that are expired at the latest stored block
+			lastBlock, err := db.GetLastBlockHeightAndTimestamp()
+			if err != nil {
+				return fmt.Errorf("error while getting last block: %s", err)
+			}
+
+			return db.DeleteExpiredAuthzGrants(lastBlock.BlockTimestamp)
+		},
+	}
+}
@@ -14,6 +14,7 @@ func NewBankCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 
 	cmd.AddCommand(
 		supplyCmd(parseConfig),
+		supplyHistoryCmd(parseConfig),
 	)
 
 	return cmd
@@ -0,0 +1,62 @@
+package bank
+
+import (
+	"fmt"
+	"strconv"
+
+	modulestypes "github.com/forbole/callisto/v4/modules/types"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+	"github.com/forbole/callisto/v4/modules/bank"
+)
+
+// supplyHistoryCmd returns the Cobra command allowing to backfill the x/bank supply history
+func supplyHistoryCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "supply-history [start height] [end height]",
+		Short: "Backfill the total supply history between the given heights. Requires an archive node",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			startHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height: %s", err)
+			}
+
+			endHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end height: %s", err)
+			}
+
+			if startHeight > endHeight {
+				return fmt.Errorf("start height must be lower than or equal to end height")
+			}
+
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build bank module
+			bankModule := bank.NewModule(config.Cfg, nil, sources.BankSource, parseCtx.EncodingConfig.Codec, db)
+
+			err = bankModule.BackfillSupplyHistory(startHeight, endHeight)
+			if err != nil {
+				return fmt.Errorf("error while backfilling supply history: %s", err)
+			}
+
+			return nil
+		},
+	}
+}
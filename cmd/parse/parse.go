@@ -11,12 +11,14 @@ import (
 	parsetransaction "github.com/forbole/juno/v5/cmd/parse/transactions"
 
 	parseauth "github.com/forbole/callisto/v4/cmd/parse/auth"
+	parseauthz "github.com/forbole/callisto/v4/cmd/parse/authz"
 	parsebank "github.com/forbole/callisto/v4/cmd/parse/bank"
 	parsedistribution "github.com/forbole/callisto/v4/cmd/parse/distribution"
 	parsefeegrant "github.com/forbole/callisto/v4/cmd/parse/feegrant"
 	parsegov "github.com/forbole/callisto/v4/cmd/parse/gov"
 	parsemint "github.com/forbole/callisto/v4/cmd/parse/mint"
 	parsepricefeed "github.com/forbole/callisto/v4/cmd/parse/pricefeed"
+	parseslashing "github.com/forbole/callisto/v4/cmd/parse/slashing"
 	parsestaking "github.com/forbole/callisto/v4/cmd/parse/staking"
 )
 
@@ -30,6 +32,7 @@ func NewParseCmd(parseCfg *parse.Config) *cobra.Command {
 
 	cmd.AddCommand(
 		parseauth.NewAuthCmd(parseCfg),
+		parseauthz.NewAuthzCmd(parseCfg),
 		parsebank.NewBankCmd(parseCfg),
 		parseblocks.NewBlocksCmd(parseCfg),
 		parsedistribution.NewDistributionCmd(parseCfg),
@@ -38,6 +41,7 @@ func NewParseCmd(parseCfg *parse.Config) *cobra.Command {
 		parsegov.NewGovCmd(parseCfg),
 		parsemint.NewMintCmd(parseCfg),
 		parsepricefeed.NewPricefeedCmd(parseCfg),
+		parseslashing.NewSlashingCmd(parseCfg),
 		parsestaking.NewStakingCmd(parseCfg),
 		parsetransaction.NewTransactionsCmd(parseCfg),
 	)
@@ -0,0 +1,21 @@
+package slashing
+
+import (
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/spf13/cobra"
+)
+
+// NewSlashingCmd returns the Cobra command that allows to fix all the things related to the x/slashing module
+func NewSlashingCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashing",
+		Short: "Fix things related to the x/slashing module",
+	}
+
+	cmd.AddCommand(
+		slashesCmd(parseConfig),
+		jailEventsCmd(parseConfig),
+	)
+
+	return cmd
+}
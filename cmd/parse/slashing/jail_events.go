@@ -0,0 +1,148 @@
+package slashing
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/parser"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+	"github.com/forbole/callisto/v4/modules/slashing"
+	modulestypes "github.com/forbole/callisto/v4/modules/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+// jailEventsCmd returns the Cobra command allowing to backfill the validator jail events
+func jailEventsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "jail-events [start height] [end height]",
+		Short: "Backfill the validator jail events by scanning the blocks and block results between the given heights",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			startHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height: %s", err)
+			}
+
+			endHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end height: %s", err)
+			}
+
+			if startHeight > endHeight {
+				return fmt.Errorf("start height must be lower than or equal to end height")
+			}
+
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build slashing module
+			slashingModule := slashing.NewModule(config.Cfg, sources.SlashingSource, parseCtx.EncodingConfig.Codec, db)
+
+			for height := startHeight; height <= endHeight; height++ {
+				log.Debug().Int64("height", height).Msg("parsing block")
+
+				err = refreshJailEvents(parseCtx, slashingModule, height)
+				if err != nil {
+					return fmt.Errorf("error while refreshing jail events at height %d: %s", height, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// refreshJailEvents stores the jailing and unjailing of the validators that happened at the given height
+func refreshJailEvents(parseCtx *parser.Context, slashingModule *slashing.Module, height int64) error {
+	block, err := parseCtx.Node.Block(height)
+	if err != nil {
+		return fmt.Errorf("error while getting block: %s", err)
+	}
+
+	results, err := parseCtx.Node.BlockResults(height)
+	if err != nil {
+		return fmt.Errorf("error while getting block results: %s", err)
+	}
+
+	slashes, err := slashing.ValidatorSlashesFromEvents(height, results.BeginBlockEvents)
+	if err != nil {
+		return err
+	}
+
+	// Get the signing infos of the slashed validators, which might not be available on pruned nodes
+	var signingInfos []types.ValidatorSigningInfo
+	for _, slash := range slashes {
+		consAddr, err := sdk.ConsAddressFromBech32(slash.ValidatorAddress)
+		if err != nil {
+			return fmt.Errorf("invalid validator consensus address %s: %s", slash.ValidatorAddress, err)
+		}
+
+		signingInfo, err := slashingModule.GetSigningInfo(height, consAddr)
+		if err != nil {
+			log.Warn().Err(err).Int64("height", height).Str("validator", slash.ValidatorAddress).
+				Msg("error while getting signing info, jailed until time will not be stored")
+			continue
+		}
+		signingInfos = append(signingInfos, signingInfo)
+	}
+
+	err = slashingModule.SaveValidatorJailEvents(height, block.Block.Time, results.BeginBlockEvents, signingInfos)
+	if err != nil {
+		return err
+	}
+
+	if len(block.Block.Txs) == 0 {
+		return nil
+	}
+
+	txs, err := parseCtx.Node.Txs(block)
+	if err != nil {
+		return fmt.Errorf("error while getting block transactions: %s", err)
+	}
+
+	// Handle the unjail messages, including the ones executed through authz
+	for _, tx := range txs {
+		for index, msg := range tx.GetMsgs() {
+			err = slashingModule.HandleMsg(index, msg, tx)
+			if err != nil {
+				return fmt.Errorf("error while handling message: %s", err)
+			}
+
+			execMsg, ok := msg.(*authz.MsgExec)
+			if !ok {
+				continue
+			}
+
+			executedMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return fmt.Errorf("error while getting executed messages: %s", err)
+			}
+
+			for executedIndex, executedMsg := range executedMsgs {
+				err = slashingModule.HandleMsgExec(index, execMsg, executedIndex, executedMsg, tx)
+				if err != nil {
+					return fmt.Errorf("error while handling executed message: %s", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
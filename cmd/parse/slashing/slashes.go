@@ -0,0 +1,71 @@
+package slashing
+
+import (
+	"fmt"
+	"strconv"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+	"github.com/forbole/callisto/v4/modules/slashing"
+	modulestypes "github.com/forbole/callisto/v4/modules/types"
+)
+
+// slashesCmd returns the Cobra command allowing to backfill the validator slashes
+func slashesCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "slashes [start height] [end height]",
+		Short: "Backfill the validator slashes by scanning the block results between the given heights",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			startHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height: %s", err)
+			}
+
+			endHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end height: %s", err)
+			}
+
+			if startHeight > endHeight {
+				return fmt.Errorf("start height must be lower than or equal to end height")
+			}
+
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build slashing module
+			slashingModule := slashing.NewModule(config.Cfg, sources.SlashingSource, parseCtx.EncodingConfig.Codec, db)
+
+			for height := startHeight; height <= endHeight; height++ {
+				log.Debug().Int64("height", height).Msg("parsing block results")
+
+				results, err := parseCtx.Node.BlockResults(height)
+				if err != nil {
+					return fmt.Errorf("error while getting block results at height %d: %s", height, err)
+				}
+
+				err = slashingModule.SaveValidatorSlashes(height, results.BeginBlockEvents)
+				if err != nil {
+					return fmt.Errorf("error while saving validator slashes at height %d: %s", height, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -13,6 +13,7 @@ func NewStakingCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		delegationsCmd(parseConfig),
 		poolCmd(parseConfig),
 		validatorsCmd(parseConfig),
 	)
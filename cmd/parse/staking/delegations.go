@@ -0,0 +1,52 @@
+package staking
+
+import (
+	"fmt"
+
+	modulestypes "github.com/forbole/callisto/v4/modules/types"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+	"github.com/forbole/callisto/v4/modules/staking"
+)
+
+// delegationsCmd returns a Cobra command that allows to fix the delegations made to all validators.
+func delegationsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delegations",
+		Short: "Fix the delegations made to all the validators taking them from the latest known height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build the staking module
+			stakingModule := staking.NewModule(config.Cfg, sources.StakingSource, parseCtx.Node, parseCtx.EncodingConfig.Codec, db)
+
+			// Get latest height
+			height, err := parseCtx.Node.LatestHeight()
+			if err != nil {
+				return fmt.Errorf("error while getting latest block height: %s", err)
+			}
+
+			err = stakingModule.RefreshAllDelegations(height)
+			if err != nil {
+				return fmt.Errorf("error while refreshing all delegations: %s", err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -2,6 +2,7 @@ package database
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
@@ -59,6 +60,84 @@ func (db *Db) saveAccounts(paramsNumber int, accounts []types.Account) error {
 	return nil
 }
 
+// SaveAccountsDetails saves the given accounts details inside the database.
+// The first and last seen heights are always updated, while the other details
+// are replaced only when the given height is greater or equal to the last seen one
+func (db *Db) SaveAccountsDetails(accounts []types.AccountDetails) error {
+	paramsNumber := 10
+	slices := dbutils.SplitAccountsDetails(accounts, paramsNumber)
+
+	for _, accounts := range slices {
+		if len(accounts) == 0 {
+			continue
+		}
+
+		err := db.saveAccountsDetails(paramsNumber, accounts)
+		if err != nil {
+			return fmt.Errorf("error while storing accounts details: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (db *Db) saveAccountsDetails(paramsNumber int, accounts []types.AccountDetails) error {
+	stmt := `
+INSERT INTO account (address, type, pub_key_type, pub_key, account_number, sequence, 
+                     module_name, module_permissions, first_seen_height, last_seen_height) 
+VALUES `
+	var params []interface{}
+
+	for i, account := range accounts {
+		ai := i * paramsNumber
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),",
+			ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7, ai+8, ai+9, ai+10)
+
+		// Accounts without a type have not been found on chain, so their details must be left empty
+		var pubKey, accountNumber, sequence, permissions interface{}
+		if account.Type != "" {
+			accountNumber, sequence = account.AccountNumber, account.Sequence
+			permissions = pq.StringArray(account.ModulePermissions)
+		}
+		if account.PubKey != nil {
+			pubKey = string(account.PubKey)
+		}
+
+		params = append(params,
+			account.Address,
+			dbtypes.ToNullString(account.Type),
+			dbtypes.ToNullString(account.PubKeyType),
+			pubKey,
+			accountNumber,
+			sequence,
+			dbtypes.ToNullString(account.ModuleName),
+			permissions,
+			account.Height,
+			account.Height,
+		)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (address) DO UPDATE 
+	SET first_seen_height = LEAST(account.first_seen_height, excluded.first_seen_height),
+		last_seen_height = GREATEST(account.last_seen_height, excluded.last_seen_height),`
+
+	// Details are updated only if they are more recent than the stored ones
+	var updates []string
+	for _, column := range []string{
+		"type", "pub_key_type", "pub_key", "account_number", "sequence", "module_name", "module_permissions",
+	} {
+		updates = append(updates, fmt.Sprintf(`
+		%[1]s = CASE WHEN account.last_seen_height IS NULL OR account.last_seen_height <= excluded.last_seen_height 
+			THEN COALESCE(excluded.%[1]s, account.%[1]s) ELSE account.%[1]s END`, column))
+	}
+	stmt += strings.Join(updates, ",")
+
+	_, err := db.SQL.Exec(stmt, params...)
+	return err
+}
+
 // SaveVestingAccounts saves the given vesting accounts inside the database
 func (db *Db) SaveVestingAccounts(vestingAccounts []exported.VestingAccount) error {
 	if len(vestingAccounts) == 0 {
@@ -67,7 +146,7 @@ func (db *Db) SaveVestingAccounts(vestingAccounts []exported.VestingAccount) err
 
 	for _, account := range vestingAccounts {
 		switch vestingAccount := account.(type) {
-		case *vestingtypes.ContinuousVestingAccount, *vestingtypes.DelayedVestingAccount:
+		case *vestingtypes.ContinuousVestingAccount, *vestingtypes.DelayedVestingAccount, *vestingtypes.PermanentLockedAccount:
 			_, err := db.storeVestingAccount(account)
 			if err != nil {
 				return err
@@ -93,7 +172,8 @@ func (db *Db) storeVestingAccount(account exported.VestingAccount) (int, error)
 	INSERT INTO vesting_account (type, address, original_vesting, end_time, start_time) 
 	VALUES ($1, $2, $3, $4, $5)
 	ON CONFLICT (address) DO UPDATE 
-		SET original_vesting = excluded.original_vesting, 
+		SET type = excluded.type,
+			original_vesting = excluded.original_vesting, 
 			end_time = excluded.end_time, 
 			start_time = excluded.start_time
 			RETURNING id `
@@ -120,34 +200,6 @@ func (db *Db) storeVestingAccount(account exported.VestingAccount) (int, error)
 	return vestingAccountRowID, nil
 }
 
-func (db *Db) StoreBaseVestingAccountFromMsg(bva *vestingtypes.BaseVestingAccount, txTimestamp time.Time) error {
-	stmt := `
-	INSERT INTO vesting_account (type, address, original_vesting, start_time, end_time) 
-	VALUES ($1, $2, $3, $4, $5)
-	ON CONFLICT (address) DO UPDATE 
-		SET type = excluded.type,
-			original_vesting = excluded.original_vesting, 
-			start_time = excluded.start_time, 
-			end_time = excluded.end_time`
-
-	// Store the vesting account
-	err := db.SaveAccounts([]types.Account{types.NewAccount(bva.GetAddress().String())})
-	if err != nil {
-		return fmt.Errorf("error while storing vesting account address: %s", err)
-	}
-
-	_, err = db.SQL.Exec(stmt,
-		proto.MessageName(bva),
-		bva.GetAddress().String(),
-		pq.Array(dbtypes.NewDbCoins(bva.OriginalVesting)),
-		txTimestamp,
-		time.Unix(bva.EndTime, 0))
-	if err != nil {
-		return fmt.Errorf("error while storing vesting account: %s", err)
-	}
-	return nil
-}
-
 // storeVestingPeriods handles storing the vesting periods of PeriodicVestingAccount type
 func (db *Db) storeVestingPeriods(id int, vestingPeriods []vestingtypes.Period) error {
 	// Delete already existing periods
@@ -157,6 +209,10 @@ func (db *Db) storeVestingPeriods(id int, vestingPeriods []vestingtypes.Period)
 		return fmt.Errorf("error while deleting vesting period: %s", err)
 	}
 
+	if len(vestingPeriods) == 0 {
+		return nil
+	}
+
 	// Store the new periods
 	stmt = `
 INSERT INTO vesting_period (vesting_account_id, period_order, length, amount) 
@@ -187,3 +243,18 @@ func (db *Db) GetAccounts() ([]string, error) {
 	err := db.Sqlx.Select(&rows, `SELECT address FROM account`)
 	return rows, err
 }
+
+// GetVestingAccounts returns all the vesting accounts that are currently stored inside the database
+func (db *Db) GetVestingAccounts() ([]dbtypes.VestingAccountRow, error) {
+	var rows []dbtypes.VestingAccountRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM vesting_account`)
+	return rows, err
+}
+
+// GetVestingPeriods returns all the vesting periods that are currently stored inside the database,
+// sorted by vesting account and period order
+func (db *Db) GetVestingPeriods() ([]dbtypes.VestingPeriodRow, error) {
+	var rows []dbtypes.VestingPeriodRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM vesting_period ORDER BY vesting_account_id, period_order`)
+	return rows, err
+}
@@ -1,8 +1,14 @@
 package database_test
 
 import (
+	"database/sql"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/lib/pq"
 
 	"github.com/forbole/callisto/v4/types"
 
@@ -39,6 +45,82 @@ func (suite *DbTestSuite) TestSaveAccount() {
 	suite.Require().True(expectedAccountRow.Equal(accountRows[0]))
 }
 
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountsDetails() {
+	address := "cosmos140xsjjg6pwkjp0xjz8zru7ytha60l5aee9nlf7"
+	moduleAddress := "cosmos1jv65s3grqf6v6jl3dp4t6c9t9rk99cd88lyufl"
+	unknownAddress := "cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt"
+
+	// Save the data
+	err := suite.database.SaveAccountsDetails([]types.AccountDetails{
+		types.NewAccountDetails(address, "cosmos.auth.v1beta1.BaseAccount",
+			"cosmos.crypto.secp256k1.PubKey", []byte(`{"key":"AiqXJ+zC0hOTXwWlRgqNdDBcHPkaNUOoC+8B7JsE8a9r"}`),
+			10, 5, "", nil, 100),
+		types.NewAccountDetails(moduleAddress, "cosmos.auth.v1beta1.ModuleAccount", "", nil,
+			1, 0, "distribution", []string{}, 100),
+		types.NewAccountDetails(unknownAddress, "", "", nil, 0, 0, "", nil, 100),
+	})
+	suite.Require().NoError(err)
+
+	// Update the data with an older height
+	err = suite.database.SaveAccountsDetails([]types.AccountDetails{
+		types.NewAccountDetails(address, "cosmos.auth.v1beta1.BaseAccount", "", nil, 10, 1, "", nil, 50),
+	})
+	suite.Require().NoError(err)
+
+	// Update the data with a newer height
+	err = suite.database.SaveAccountsDetails([]types.AccountDetails{
+		types.NewAccountDetails(unknownAddress, "cosmos.auth.v1beta1.ModuleAccount", "", nil,
+			2, 0, "gov", []string{"burner"}, 150),
+	})
+	suite.Require().NoError(err)
+
+	// Verify the data
+	expected := []dbtypes.AccountRow{
+		{
+			Address:         address,
+			Type:            sql.NullString{String: "cosmos.auth.v1beta1.BaseAccount", Valid: true},
+			PubKeyType:      sql.NullString{String: "cosmos.crypto.secp256k1.PubKey", Valid: true},
+			PubKey:          sql.NullString{String: `{"key": "AiqXJ+zC0hOTXwWlRgqNdDBcHPkaNUOoC+8B7JsE8a9r"}`, Valid: true},
+			AccountNumber:   sql.NullInt64{Int64: 10, Valid: true},
+			Sequence:        sql.NullInt64{Int64: 5, Valid: true},
+			FirstSeenHeight: sql.NullInt64{Int64: 50, Valid: true},
+			LastSeenHeight:  sql.NullInt64{Int64: 100, Valid: true},
+		},
+		{
+			Address:           moduleAddress,
+			Type:              sql.NullString{String: "cosmos.auth.v1beta1.ModuleAccount", Valid: true},
+			AccountNumber:     sql.NullInt64{Int64: 1, Valid: true},
+			Sequence:          sql.NullInt64{Int64: 0, Valid: true},
+			ModuleName:        sql.NullString{String: "distribution", Valid: true},
+			ModulePermissions: pq.StringArray{},
+			FirstSeenHeight:   sql.NullInt64{Int64: 100, Valid: true},
+			LastSeenHeight:    sql.NullInt64{Int64: 100, Valid: true},
+		},
+		{
+			Address:           unknownAddress,
+			Type:              sql.NullString{String: "cosmos.auth.v1beta1.ModuleAccount", Valid: true},
+			AccountNumber:     sql.NullInt64{Int64: 2, Valid: true},
+			Sequence:          sql.NullInt64{Int64: 0, Valid: true},
+			ModuleName:        sql.NullString{String: "gov", Valid: true},
+			ModulePermissions: pq.StringArray{"burner"},
+			FirstSeenHeight:   sql.NullInt64{Int64: 100, Valid: true},
+			LastSeenHeight:    sql.NullInt64{Int64: 150, Valid: true},
+		},
+	}
+
+	var rows []dbtypes.AccountRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM account ORDER BY first_seen_height, address`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for _, row := range rows {
+		var found bool
+		for _, expectedRow := range expected {
+			found = found || expectedRow.Equal(row)
+		}
+		suite.Require().True(found, "unexpected row: %v", row)
+	}
+}
+
 func (suite *DbTestSuite) TestBigDipperDb_GetAccounts() {
 	// Insert the data
 	queries := []string{
@@ -71,3 +153,164 @@ func (suite *DbTestSuite) TestBigDipperDb_GetAccounts() {
 		suite.Require().Equal(acc, accounts[index])
 	}
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveVestingAccounts() {
+	periodicAddress, err := sdk.AccAddressFromBech32("cosmos140xsjjg6pwkjp0xjz8zru7ytha60l5aee9nlf7")
+	suite.Require().NoError(err)
+
+	lockedAddress, err := sdk.AccAddressFromBech32("cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt")
+	suite.Require().NoError(err)
+
+	periods := vestingtypes.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(10)))},
+		{Length: 200, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(20)))},
+	}
+
+	vestingAccounts := []exported.VestingAccount{
+		vestingtypes.NewPeriodicVestingAccount(
+			authttypes.NewBaseAccountWithAddress(periodicAddress),
+			sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(30))),
+			1000,
+			periods,
+		),
+		vestingtypes.NewPermanentLockedAccount(
+			authttypes.NewBaseAccountWithAddress(lockedAddress),
+			sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(50))),
+		),
+	}
+
+	// Save the data twice to make sure periods are not duplicated
+	err = suite.database.SaveVestingAccounts(vestingAccounts)
+	suite.Require().NoError(err)
+	err = suite.database.SaveVestingAccounts(vestingAccounts)
+	suite.Require().NoError(err)
+
+	// Verify the accounts
+	accounts, err := suite.database.GetVestingAccounts()
+	suite.Require().NoError(err)
+	suite.Require().Len(accounts, 2)
+
+	accountsTypes := map[string]string{}
+	for _, account := range accounts {
+		accountsTypes[account.Address] = account.Type
+	}
+	suite.Require().Equal(map[string]string{
+		periodicAddress.String(): "cosmos.vesting.v1beta1.PeriodicVestingAccount",
+		lockedAddress.String():   "cosmos.vesting.v1beta1.PermanentLockedAccount",
+	}, accountsTypes)
+
+	// Verify the periods
+	storedPeriods, err := suite.database.GetVestingPeriods()
+	suite.Require().NoError(err)
+	suite.Require().Len(storedPeriods, 2)
+	suite.Require().Equal(int64(100), storedPeriods[0].Length)
+	suite.Require().Equal(int64(200), storedPeriods[1].Length)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_VestingAccountAmounts() {
+	address, err := sdk.AccAddressFromBech32("cosmos140xsjjg6pwkjp0xjz8zru7ytha60l5aee9nlf7")
+	suite.Require().NoError(err)
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = suite.database.SaveVestingAccounts([]exported.VestingAccount{
+		vestingtypes.NewPeriodicVestingAccount(
+			authttypes.NewBaseAccountWithAddress(address),
+			sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(30))),
+			startTime.Unix(),
+			vestingtypes.Periods{
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(10)))},
+				{Length: 200, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(20)))},
+			},
+		),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveAccountBalances([]types.AccountBalance{
+		types.NewAccountBalance(address.String(), sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(40))), 10),
+	})
+	suite.Require().NoError(err)
+
+	type amountsRow struct {
+		Denom           string `db:"denom"`
+		OriginalVesting string `db:"original_vesting"`
+		Vested          string `db:"vested"`
+		Locked          string `db:"locked"`
+		Spendable       string `db:"spendable"`
+	}
+
+	// Only the first period has ended
+	var rows []amountsRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM vesting_account_amounts($1, $2)`,
+		address.String(), startTime.Add(150*time.Second))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]amountsRow{{"uatom", "30", "10", "20", "20"}}, rows)
+
+	// All the periods have ended
+	rows = nil
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM vesting_account_amounts($1, $2)`,
+		address.String(), startTime.Add(300*time.Second))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]amountsRow{{"uatom", "30", "30", "0", "40"}}, rows)
+
+	// The amounts at a given height should be computed using the block timestamp
+	suite.getBlock(20)
+	_, err = suite.database.SQL.Exec(`UPDATE block SET timestamp = $1 WHERE height = 20`,
+		startTime.Add(150*time.Second))
+	suite.Require().NoError(err)
+
+	rows = nil
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM vesting_account_amounts_at_height($1, $2)`,
+		address.String(), 20)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]amountsRow{{"uatom", "30", "10", "20", "20"}}, rows)
+
+	// No amounts should be returned for heights that are not stored
+	rows = nil
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM vesting_account_amounts_at_height($1, $2)`,
+		address.String(), 21)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rows)
+
+	// Nothing should be vested at the start time
+	rows = nil
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM vesting_account_amounts($1, $2)`,
+		address.String(), startTime)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]amountsRow{{"uatom", "30", "0", "30", "10"}}, rows)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_VestingAccountAmounts_Continuous() {
+	address, err := sdk.AccAddressFromBech32("cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2")
+	suite.Require().NoError(err)
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	baseAccount := vestingtypes.NewBaseVestingAccount(
+		authttypes.NewBaseAccountWithAddress(address),
+		sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(3)), sdk.NewCoin("ustake", sdk.NewInt(5))),
+		startTime.Add(2*time.Second).Unix(),
+	)
+
+	err = suite.database.SaveVestingAccounts([]exported.VestingAccount{
+		vestingtypes.NewContinuousVestingAccountRaw(baseAccount, startTime.Unix()),
+	})
+	suite.Require().NoError(err)
+
+	type amountsRow struct {
+		Denom           string `db:"denom"`
+		OriginalVesting string `db:"original_vesting"`
+		Vested          string `db:"vested"`
+		Locked          string `db:"locked"`
+		Spendable       string `db:"spendable"`
+	}
+
+	// Half of the amounts have vested, and the fractional midpoints should be rounded
+	// to the nearest even integer like the SDK does (1.5 -> 2 and 2.5 -> 2)
+	var rows []amountsRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM vesting_account_amounts($1, $2)`,
+		address.String(), startTime.Add(time.Second))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]amountsRow{
+		{"uatom", "3", "2", "1", "0"},
+		{"ustake", "5", "2", "3", "0"},
+	}, rows)
+}
@@ -0,0 +1,112 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// SaveAuthzGrant allows to store the given authz grant
+func (db *Db) SaveAuthzGrant(grant types.AuthzGrant) error {
+	// Store the accounts
+	err := db.SaveAccounts([]types.Account{types.NewAccount(grant.Granter), types.NewAccount(grant.Grantee)})
+	if err != nil {
+		return fmt.Errorf("error while storing authz grant accounts: %s", err)
+	}
+
+	stmt := `
+INSERT INTO authz_grant (granter_address, grantee_address, msg_type_url, authorization_type, authorization, expiration, height) 
+VALUES ($1, $2, $3, $4, $5, $6, $7) 
+ON CONFLICT ON CONSTRAINT unique_authz_grant DO UPDATE 
+    SET authorization_type = excluded.authorization_type,
+        authorization = excluded.authorization,
+        expiration = excluded.expiration,
+        height = excluded.height
+WHERE authz_grant.height <= excluded.height`
+
+	authorizationJSON, err := codec.ProtoMarshalJSON(grant.Authorization, nil)
+	if err != nil {
+		return fmt.Errorf("error while marshaling authorization: %s", err)
+	}
+
+	_, err = db.SQL.Exec(stmt,
+		grant.Granter,
+		grant.Grantee,
+		grant.Authorization.MsgTypeURL(),
+		proto.MessageName(grant.Authorization),
+		string(authorizationJSON),
+		grant.Expiration,
+		grant.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while saving authz grant: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteAuthzGrant removes the given authz grant from the database
+func (db *Db) DeleteAuthzGrant(removal types.AuthzGrantRemoval) error {
+	stmt := `
+DELETE FROM authz_grant 
+WHERE granter_address = $1 AND grantee_address = $2 AND msg_type_url = $3 AND height <= $4`
+	_, err := db.SQL.Exec(stmt, removal.Granter, removal.Grantee, removal.MsgTypeURL, removal.Height)
+	if err != nil {
+		return fmt.Errorf("error while deleting authz grant: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredAuthzGrants removes all the authz grants that are expired at the given time
+func (db *Db) DeleteExpiredAuthzGrants(timestamp time.Time) error {
+	_, err := db.SQL.Exec(`DELETE FROM authz_grant WHERE expiration <= $1`, timestamp)
+	if err != nil {
+		return fmt.Errorf("error while deleting expired authz grants: %s", err)
+	}
+
+	return nil
+}
+
+// SaveAuthzGrantUsages allows to store the given authz grant usages
+func (db *Db) SaveAuthzGrantUsages(usages []types.AuthzGrantUsage) error {
+	if len(usages) == 0 {
+		return nil
+	}
+
+	// Store the accounts
+	var accounts []types.Account
+	for _, usage := range usages {
+		accounts = append(accounts, types.NewAccount(usage.Granter), types.NewAccount(usage.Grantee))
+	}
+	err := db.SaveAccounts(accounts)
+	if err != nil {
+		return fmt.Errorf("error while storing authz grant usage accounts: %s", err)
+	}
+
+	stmt := `
+INSERT INTO authz_grant_usage (transaction_hash, msg_index, executed_msg_index, granter_address, grantee_address, msg_type_url, height) 
+VALUES `
+	var params []interface{}
+
+	for i, usage := range usages {
+		ui := i * 7
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d),", ui+1, ui+2, ui+3, ui+4, ui+5, ui+6, ui+7)
+		params = append(params, usage.TxHash, usage.MsgIndex, usage.ExecutedMsgIndex,
+			usage.Granter, usage.Grantee, usage.MsgTypeURL, usage.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += " ON CONFLICT ON CONSTRAINT unique_authz_grant_usage DO NOTHING"
+
+	_, err = db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving authz grant usages: %s", err)
+	}
+
+	return nil
+}
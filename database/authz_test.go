@@ -0,0 +1,151 @@
+package database_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAuthzGrant() {
+	granter := "cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt"
+	grantee := "cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn"
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	expiration := time.Date(2022, 1, 1, 00, 00, 00, 000, time.UTC)
+
+	// Store the grant
+	err := suite.database.SaveAuthzGrant(types.NewAuthzGrant(
+		granter, grantee, authztypes.NewGenericAuthorization(msgTypeURL), &expiration, 10,
+	))
+	suite.Require().NoError(err)
+
+	// Test double insertion
+	err = suite.database.SaveAuthzGrant(types.NewAuthzGrant(
+		granter, grantee, authztypes.NewGenericAuthorization(msgTypeURL), &expiration, 10,
+	))
+	suite.Require().NoError(err, "storing existing authz grant should return no error")
+
+	// Test update with lower height
+	err = suite.database.SaveAuthzGrant(types.NewAuthzGrant(
+		granter, grantee, authztypes.NewGenericAuthorization(msgTypeURL), nil, 9,
+	))
+	suite.Require().NoError(err)
+
+	// Verify the data
+	var rows []struct {
+		MsgTypeURL        string     `db:"msg_type_url"`
+		AuthorizationType string     `db:"authorization_type"`
+		Expiration        *time.Time `db:"expiration"`
+		Height            int64      `db:"height"`
+	}
+	err = suite.database.Sqlx.Select(&rows,
+		`SELECT msg_type_url, authorization_type, expiration, height FROM authz_grant`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(msgTypeURL, rows[0].MsgTypeURL)
+	suite.Require().Equal("cosmos.authz.v1beta1.GenericAuthorization", rows[0].AuthorizationType)
+	suite.Require().NotNil(rows[0].Expiration)
+	suite.Require().True(expiration.Equal(*rows[0].Expiration))
+	suite.Require().Equal(int64(10), rows[0].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_DeleteAuthzGrant() {
+	granter := "cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt"
+	grantee := "cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn"
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	err := suite.database.SaveAuthzGrant(types.NewAuthzGrant(
+		granter, grantee, authztypes.NewGenericAuthorization(msgTypeURL), nil, 10,
+	))
+	suite.Require().NoError(err)
+
+	// Removals happened before the grant should be ignored
+	err = suite.database.DeleteAuthzGrant(types.NewAuthzGrantRemoval(granter, grantee, msgTypeURL, 9))
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM authz_grant`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+
+	// Delete the data
+	err = suite.database.DeleteAuthzGrant(types.NewAuthzGrantRemoval(granter, grantee, msgTypeURL, 10))
+	suite.Require().NoError(err)
+
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM authz_grant`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_DeleteExpiredAuthzGrants() {
+	granter := "cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt"
+	grantee := "cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn"
+	expiration := time.Date(2022, 1, 1, 00, 00, 00, 000, time.UTC)
+
+	err := suite.database.SaveAuthzGrant(types.NewAuthzGrant(
+		granter, grantee, authztypes.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})), &expiration, 10,
+	))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveAuthzGrant(types.NewAuthzGrant(
+		granter, grantee, authztypes.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{})), nil, 10,
+	))
+	suite.Require().NoError(err)
+
+	err = suite.database.DeleteExpiredAuthzGrants(expiration.Add(-time.Second))
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM authz_grant`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, count)
+
+	err = suite.database.DeleteExpiredAuthzGrants(expiration)
+	suite.Require().NoError(err)
+
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM authz_grant`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAuthzGrantUsages() {
+	usages := []types.AuthzGrantUsage{
+		types.NewAuthzGrantUsage(
+			"A5B6F7C8", 0, 0,
+			"cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt",
+			"cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn",
+			sdk.MsgTypeURL(&banktypes.MsgSend{}),
+			10,
+		),
+		types.NewAuthzGrantUsage(
+			"A5B6F7C8", 0, 1,
+			"cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt",
+			"cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn",
+			sdk.MsgTypeURL(&banktypes.MsgSend{}),
+			10,
+		),
+	}
+
+	err := suite.database.SaveAuthzGrantUsages(usages)
+	suite.Require().NoError(err)
+
+	// Test double insertion
+	err = suite.database.SaveAuthzGrantUsages(usages)
+	suite.Require().NoError(err, "storing existing authz grant usages should return no error")
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM authz_grant_usage`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, count)
+
+	// Pruning the height should remove the usages
+	err = suite.database.Prune(10)
+	suite.Require().NoError(err)
+
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM authz_grant_usage`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Zero(count)
+}
@@ -2,8 +2,10 @@ package database
 
 import (
 	"fmt"
+	"time"
 
 	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lib/pq"
@@ -26,3 +28,189 @@ WHERE supply.height <= excluded.height`
 
 	return nil
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveAccountBalances allows to store the given balances inside the database
+func (db *Db) SaveAccountBalances(balances []types.AccountBalance) error {
+	if len(balances) == 0 {
+		return nil
+	}
+
+	// Store the accounts
+	accounts := make([]types.Account, len(balances))
+	for index, balance := range balances {
+		accounts[index] = types.NewAccount(balance.Address)
+	}
+	err := db.SaveAccounts(accounts)
+	if err != nil {
+		return fmt.Errorf("error while storing balance accounts: %s", err)
+	}
+
+	stmt := `INSERT INTO account_balance (address, coins, height) VALUES `
+	var params []interface{}
+
+	for i, balance := range balances {
+		bi := i * 3
+		stmt += fmt.Sprintf("($%d,$%d,$%d),", bi+1, bi+2, bi+3)
+		params = append(params, balance.Address, pq.Array(dbtypes.NewDbCoins(balance.Balance)), balance.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT (address) DO UPDATE 
+	SET coins = excluded.coins,
+	    height = excluded.height
+WHERE account_balance.height <= excluded.height`
+
+	_, err = db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing account balances: %s", err)
+	}
+
+	return nil
+}
+
+// SaveSupplyHistory stores the given total supply as the one of the bucket starting at the given timestamp.
+// If the bucket already contains a supply, it is replaced only if the given height is greater or equal to the stored one.
+func (db *Db) SaveSupplyHistory(coins sdk.Coins, timestamp time.Time, height int64) error {
+	if len(coins) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO supply_history (denom, amount, timestamp, height) VALUES `
+	var params []interface{}
+
+	for i, coin := range coins {
+		ci := i * 4
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d),", ci+1, ci+2, ci+3, ci+4)
+		params = append(params, coin.Denom, coin.Amount.String(), timestamp, height)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT ON CONSTRAINT unique_supply_history DO UPDATE 
+	SET amount = excluded.amount,
+	    height = excluded.height
+WHERE supply_history.height <= excluded.height`
+
+	_, err := db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing supply history: %s", err)
+	}
+
+	return nil
+}
+
+// SaveTokenTransfers stores the given token transfers inside the database, one row for each transferred denom
+func (db *Db) SaveTokenTransfers(transfers []types.TokenTransfer) error {
+	stmt := `
+INSERT INTO token_transfer (transaction_hash, msg_index, leg_index, from_address, to_address, denom, amount, height) 
+VALUES `
+	var params []interface{}
+
+	i := 0
+	for _, transfer := range transfers {
+		for _, coin := range transfer.Amount {
+			ti := i * 8
+			stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),", ti+1, ti+2, ti+3, ti+4, ti+5, ti+6, ti+7, ti+8)
+			params = append(params, transfer.TxHash, transfer.MsgIndex, transfer.LegIndex,
+				transfer.FromAddress, transfer.ToAddress, coin.Denom, coin.Amount.String(), transfer.Height)
+			i++
+		}
+	}
+
+	if len(params) == 0 {
+		return nil
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += " ON CONFLICT ON CONSTRAINT unique_token_transfer DO NOTHING"
+
+	_, err := db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing token transfers: %s", err)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveCirculatingSupply allows to save for the given height the given circulating supply
+func (db *Db) SaveCirculatingSupply(coins sdk.Coins, height int64) error {
+	query := `
+INSERT INTO circulating_supply (coins, height) 
+VALUES ($1, $2) 
+ON CONFLICT (one_row_id) DO UPDATE 
+    SET coins = excluded.coins,
+    	height = excluded.height
+WHERE circulating_supply.height <= excluded.height`
+
+	_, err := db.SQL.Exec(query, pq.Array(dbtypes.NewDbCoins(coins)), height)
+	if err != nil {
+		return fmt.Errorf("error while storing circulating supply: %s", err)
+	}
+
+	return nil
+}
+
+// SaveCirculatingSupplyHistory stores the given circulating supply as the one of the bucket starting at the given timestamp.
+// If the bucket already contains a value, it is replaced only if the given height is greater or equal to the stored one.
+func (db *Db) SaveCirculatingSupplyHistory(coins sdk.Coins, timestamp time.Time, height int64) error {
+	if len(coins) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO circulating_supply_history (denom, amount, timestamp, height) VALUES `
+	var params []interface{}
+
+	for i, coin := range coins {
+		ci := i * 4
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d),", ci+1, ci+2, ci+3, ci+4)
+		params = append(params, coin.Denom, coin.Amount.String(), timestamp, height)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT ON CONSTRAINT unique_circulating_supply_history DO UPDATE 
+	SET amount = excluded.amount,
+	    height = excluded.height
+WHERE circulating_supply_history.height <= excluded.height`
+
+	_, err := db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing circulating supply history: %s", err)
+	}
+
+	return nil
+}
+
+// GetCirculatingSupply returns the latest circulating supply stored inside the database along with its height.
+// If no circulating supply has been stored yet, it returns nil
+func (db *Db) GetCirculatingSupply() (*dbtypes.SupplyRow, error) {
+	var rows []dbtypes.SupplyRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM circulating_supply`)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return &rows[0], nil
+}
+
+// GetCirculatingSupplyHistory returns the most recent circulating supply history values
+// that have been computed at a height lower or equal to the given one
+func (db *Db) GetCirculatingSupplyHistory(height int64) ([]dbtypes.SupplyHistoryRow, error) {
+	stmt := `
+SELECT denom, amount, timestamp, height FROM circulating_supply_history 
+WHERE height = (SELECT MAX(height) FROM circulating_supply_history WHERE height <= $1)
+ORDER BY denom`
+
+	var rows []dbtypes.SupplyHistoryRow
+	err := db.Sqlx.Select(&rows, stmt, height)
+	return rows, err
+}
@@ -1,11 +1,14 @@
 package database_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	dbtypes "github.com/forbole/callisto/v4/database/types"
 
 	bddbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
 )
 
 func (suite *DbTestSuite) TestBigDipperDb_SaveSupply() {
@@ -75,3 +78,212 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveSupply() {
 	suite.Require().Len(rows, 1, "supply table should contain only one row")
 	suite.Require().True(expected.Equals(rows[0]))
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountBalances() {
+	address := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+
+	// Save the data
+	original := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))
+	err := suite.database.SaveAccountBalances([]types.AccountBalance{
+		types.NewAccountBalance(address.String(), original, 10),
+	})
+	suite.Require().NoError(err)
+
+	// Verify the data
+	expected := bddbtypes.NewAccountBalanceRow(address.String(), dbtypes.NewDbCoins(original), 10)
+
+	var rows []bddbtypes.AccountBalanceRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM account_balance`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().True(expected.Equals(rows[0]))
+
+	// ----------------------------------------------------------------------------------------------------------------
+
+	// Try updating with a lower height
+	err = suite.database.SaveAccountBalances([]types.AccountBalance{
+		types.NewAccountBalance(address.String(), sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(5))), 9),
+	})
+	suite.Require().NoError(err)
+
+	// Verify the data
+	rows = []bddbtypes.AccountBalanceRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM account_balance`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().True(expected.Equals(rows[0]))
+
+	// ----------------------------------------------------------------------------------------------------------------
+
+	// Try updating with a higher height and a new account
+	coins := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(50)), sdk.NewCoin("udesmos", sdk.NewInt(10)))
+	err = suite.database.SaveAccountBalances([]types.AccountBalance{
+		types.NewAccountBalance(address.String(), coins, 11),
+		types.NewAccountBalance("cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2", sdk.NewCoins(), 11),
+	})
+	suite.Require().NoError(err)
+
+	// Verify the data
+	expectedRows := []bddbtypes.AccountBalanceRow{
+		bddbtypes.NewAccountBalanceRow("cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2", dbtypes.NewDbCoins(sdk.NewCoins()), 11),
+		bddbtypes.NewAccountBalanceRow(address.String(), dbtypes.NewDbCoins(coins), 11),
+	}
+
+	rows = []bddbtypes.AccountBalanceRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM account_balance ORDER BY address`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expectedRows))
+	for index, row := range rows {
+		suite.Require().True(expectedRows[index].Equals(row))
+	}
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveSupplyHistory() {
+	timestamp := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Save the data
+	err := suite.database.SaveSupplyHistory(sdk.NewCoins(
+		sdk.NewCoin("desmos", sdk.NewInt(10000)),
+		sdk.NewCoin("uatom", sdk.NewInt(15)),
+	), timestamp, 10)
+	suite.Require().NoError(err)
+
+	// Try updating the same bucket with a lower height
+	err = suite.database.SaveSupplyHistory(sdk.NewCoins(
+		sdk.NewCoin("uatom", sdk.NewInt(10)),
+	), timestamp, 9)
+	suite.Require().NoError(err)
+
+	// Try updating the same bucket with a higher height
+	err = suite.database.SaveSupplyHistory(sdk.NewCoins(
+		sdk.NewCoin("desmos", sdk.NewInt(20000)),
+	), timestamp, 11)
+	suite.Require().NoError(err)
+
+	// Save a new bucket
+	err = suite.database.SaveSupplyHistory(sdk.NewCoins(
+		sdk.NewCoin("uatom", sdk.NewInt(30)),
+	), timestamp.Add(24*time.Hour), 20)
+	suite.Require().NoError(err)
+
+	// Verify the data
+	expected := []bddbtypes.SupplyHistoryRow{
+		bddbtypes.NewSupplyHistoryRow("desmos", "20000", timestamp, 11),
+		bddbtypes.NewSupplyHistoryRow("uatom", "15", timestamp, 10),
+		bddbtypes.NewSupplyHistoryRow("uatom", "30", timestamp.Add(24*time.Hour), 20),
+	}
+
+	var rows []bddbtypes.SupplyHistoryRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM supply_history ORDER BY timestamp, denom`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for index, row := range rows {
+		suite.Require().True(expected[index].Equals(row))
+	}
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveTokenTransfers() {
+	transfers := []types.TokenTransfer{
+		types.NewTokenTransfer(
+			"hash", 0, 0,
+			"cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2",
+			"cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+			sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)), sdk.NewCoin("udesmos", sdk.NewInt(10))),
+			10,
+		),
+		types.NewTokenTransfer(
+			"hash", 0, 1,
+			"cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2",
+			"cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+			sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(50))),
+			10,
+		),
+	}
+
+	// Save the data twice to make sure no duplicates are stored
+	err := suite.database.SaveTokenTransfers(transfers)
+	suite.Require().NoError(err)
+	err = suite.database.SaveTokenTransfers(transfers)
+	suite.Require().NoError(err)
+
+	// Verify the data
+	var rows []struct {
+		LegIndex int64  `db:"leg_index"`
+		Denom    string `db:"denom"`
+		Amount   string `db:"amount"`
+	}
+	err = suite.database.Sqlx.Select(&rows, `SELECT leg_index, denom, amount FROM token_transfer ORDER BY leg_index, denom`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 3)
+	suite.Require().Equal(int64(0), rows[0].LegIndex)
+	suite.Require().Equal("uatom", rows[0].Denom)
+	suite.Require().Equal("100", rows[0].Amount)
+	suite.Require().Equal("udesmos", rows[1].Denom)
+	suite.Require().Equal("10", rows[1].Amount)
+	suite.Require().Equal(int64(1), rows[2].LegIndex)
+	suite.Require().Equal("50", rows[2].Amount)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveCirculatingSupply() {
+	// Save the data
+	original := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))
+	err := suite.database.SaveCirculatingSupply(original, 10)
+	suite.Require().NoError(err)
+
+	// Try updating with a lower height
+	err = suite.database.SaveCirculatingSupply(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(50))), 9)
+	suite.Require().NoError(err)
+
+	row, err := suite.database.GetCirculatingSupply()
+	suite.Require().NoError(err)
+	suite.Require().NotNil(row)
+	suite.Require().True(row.Equals(bddbtypes.NewSupplyRow(bddbtypes.NewDbCoins(original), 10)))
+
+	// Try updating with a higher height
+	updated := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(200)))
+	err = suite.database.SaveCirculatingSupply(updated, 11)
+	suite.Require().NoError(err)
+
+	row, err = suite.database.GetCirculatingSupply()
+	suite.Require().NoError(err)
+	suite.Require().NotNil(row)
+	suite.Require().True(row.Equals(bddbtypes.NewSupplyRow(bddbtypes.NewDbCoins(updated), 11)))
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetCirculatingSupplyHistory() {
+	timestamp := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	err := suite.database.SaveCirculatingSupplyHistory(sdk.NewCoins(
+		sdk.NewCoin("desmos", sdk.NewInt(10000)),
+		sdk.NewCoin("uatom", sdk.NewInt(15)),
+	), timestamp, 10)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveCirculatingSupplyHistory(sdk.NewCoins(
+		sdk.NewCoin("uatom", sdk.NewInt(30)),
+	), timestamp.Add(24*time.Hour), 20)
+	suite.Require().NoError(err)
+
+	// Height before any value
+	rows, err := suite.database.GetCirculatingSupplyHistory(5)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rows)
+
+	// Height between the two buckets
+	rows, err = suite.database.GetCirculatingSupplyHistory(15)
+	suite.Require().NoError(err)
+	expected := []bddbtypes.SupplyHistoryRow{
+		bddbtypes.NewSupplyHistoryRow("desmos", "10000", timestamp, 10),
+		bddbtypes.NewSupplyHistoryRow("uatom", "15", timestamp, 10),
+	}
+	suite.Require().Len(rows, len(expected))
+	for index, row := range rows {
+		suite.Require().True(expected[index].Equals(row))
+	}
+
+	// Height after the last bucket
+	rows, err = suite.database.GetCirculatingSupplyHistory(25)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().True(bddbtypes.NewSupplyHistoryRow("uatom", "30", timestamp.Add(24*time.Hour), 20).Equals(rows[0]))
+}
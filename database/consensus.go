@@ -80,6 +80,27 @@ func (db *Db) GetBlockHeightTimeDayAgo(now time.Time) (dbtypes.BlockRow, error)
 	return db.getBlockHeightTime(pastTime)
 }
 
+// GetLastBlocksPerInterval returns the height and timestamp of the last block stored for each time interval
+// (e.g. "hour" or "day") between the given start and end heights, ordered by ascending height
+func (db *Db) GetLastBlocksPerInterval(interval string, startHeight int64, endHeight int64) ([]dbtypes.BlockHeightAndTimestamp, error) {
+	stmt := `
+SELECT height, timestamp FROM (
+	SELECT DISTINCT ON (date_trunc($1, timestamp)) height, timestamp 
+	FROM block 
+	WHERE height BETWEEN $2 AND $3
+	ORDER BY date_trunc($1, timestamp), height DESC
+) AS last_blocks
+ORDER BY height`
+
+	var blocks []dbtypes.BlockHeightAndTimestamp
+	err := db.Sqlx.Select(&blocks, stmt, interval, startHeight, endHeight)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting last blocks per %s: %s", interval, err)
+	}
+
+	return blocks, nil
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 // SaveAverageBlockTimePerMin save the average block time in average_block_time_per_minute table
@@ -188,3 +209,14 @@ func (db *Db) GetGenesis() (*types.Genesis, error) {
 	row := rows[0]
 	return types.NewGenesis(row.ChainID, row.Time, row.InitialHeight), nil
 }
+
+// GetFirstBlockHeightAfter returns the height of the first block stored inside the database
+// having a timestamp greater than the given one, or 0 if no such block exists
+func (db *Db) GetFirstBlockHeightAfter(timestamp time.Time) (int64, error) {
+	var height int64
+	err := db.SQL.QueryRow(`SELECT COALESCE(MIN(height), 0) FROM block WHERE timestamp > $1`, timestamp).Scan(&height)
+	if err != nil {
+		return 0, fmt.Errorf("error while getting first block height after %s: %s", timestamp, err)
+	}
+	return height, nil
+}
@@ -29,6 +29,22 @@ WHERE community_pool.height <= excluded.height`
 	return nil
 }
 
+// GetCommunityPool returns the community pool currently stored inside the database.
+// If no community pool has been stored yet, it returns an empty set of coins
+func (db *Db) GetCommunityPool() (sdk.DecCoins, error) {
+	var rows []dbtypes.CommunityPoolRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM community_pool`)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return sdk.NewDecCoins(), nil
+	}
+
+	return rows[0].Coins.ToDecCoins(), nil
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 // SaveDistributionParams allows to store the given distribution parameters inside the database
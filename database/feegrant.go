@@ -2,9 +2,12 @@ package database
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/lib/pq"
 
+	dbtypes "github.com/forbole/callisto/v4/database/types"
 	"github.com/forbole/callisto/v4/types"
 )
 
@@ -19,10 +22,11 @@ func (db *Db) SaveFeeGrantAllowance(allowance types.FeeGrant) error {
 	}
 
 	stmt := `
-INSERT INTO fee_grant_allowance(grantee_address, granter_address, allowance, height) 
-VALUES ($1, $2, $3, $4) 
+INSERT INTO fee_grant_allowance(grantee_address, granter_address, allowance, expiration, height) 
+VALUES ($1, $2, $3, $4, $5) 
 ON CONFLICT ON CONSTRAINT unique_fee_grant_allowance DO UPDATE 
     SET allowance = excluded.allowance,
+        expiration = excluded.expiration,
         height = excluded.height
 WHERE fee_grant_allowance.height <= excluded.height`
 
@@ -31,7 +35,17 @@ WHERE fee_grant_allowance.height <= excluded.height`
 		return fmt.Errorf("error while marshaling grant allowance: %s", err)
 	}
 
-	_, err = db.SQL.Exec(stmt, allowance.Grantee, allowance.Granter, allowanceJSON, allowance.Height)
+	feeAllowance, err := allowance.GetGrant()
+	if err != nil {
+		return fmt.Errorf("error while getting grant allowance: %s", err)
+	}
+
+	expiration, err := feeAllowance.ExpiresAt()
+	if err != nil {
+		return fmt.Errorf("error while getting grant allowance expiration: %s", err)
+	}
+
+	_, err = db.SQL.Exec(stmt, allowance.Grantee, allowance.Granter, allowanceJSON, expiration, allowance.Height)
 	if err != nil {
 		return fmt.Errorf("error while saving fee grant allowance: %s", err)
 	}
@@ -39,13 +53,93 @@ WHERE fee_grant_allowance.height <= excluded.height`
 	return nil
 }
 
-// DeleteFeeGrantAllowance removes the fee grant allowance data from the database
+// SaveFeeGrantAllowanceHistory stores the given status transition of the provided fee grant allowance
+func (db *Db) SaveFeeGrantAllowanceHistory(allowance types.FeeGrant, status types.FeeGrantStatus, txHash string) error {
+	// Store the accounts
+	var accounts []types.Account
+	accounts = append(accounts, types.NewAccount(allowance.Granter), types.NewAccount(allowance.Grantee))
+	err := db.SaveAccounts(accounts)
+	if err != nil {
+		return fmt.Errorf("error while storing fee grant allowance accounts: %s", err)
+	}
+
+	stmt := `
+INSERT INTO fee_grant_allowance_history(grantee_address, granter_address, allowance, status, transaction_hash, height) 
+VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6) 
+ON CONFLICT ON CONSTRAINT unique_fee_grant_allowance_history DO NOTHING`
+
+	allowanceJSON, err := codec.ProtoMarshalJSON(allowance.Allowance, nil)
+	if err != nil {
+		return fmt.Errorf("error while marshaling grant allowance: %s", err)
+	}
+
+	_, err = db.SQL.Exec(stmt, allowance.Grantee, allowance.Granter, allowanceJSON, status, txHash, allowance.Height)
+	if err != nil {
+		return fmt.Errorf("error while saving fee grant allowance history: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteFeeGrantAllowance removes the fee grant allowance data from the database,
+// storing its removal inside the fee grant allowance history
 func (db *Db) DeleteFeeGrantAllowance(allowance types.GrantRemoval) error {
-	stmt := `DELETE FROM fee_grant_allowance WHERE grantee_address = $1 AND granter_address = $2 AND height <= $3`
-	_, err := db.SQL.Exec(stmt, allowance.Grantee, allowance.Granter, allowance.Height)
+	stmt := `
+WITH removed AS (
+    DELETE FROM fee_grant_allowance WHERE grantee_address = $1 AND granter_address = $2 AND height <= $3
+    RETURNING grantee_address, granter_address, allowance
+)
+INSERT INTO fee_grant_allowance_history (grantee_address, granter_address, allowance, status, transaction_hash, height)
+SELECT grantee_address, granter_address, allowance, $4, NULLIF($5, ''), $3 FROM removed
+ON CONFLICT ON CONSTRAINT unique_fee_grant_allowance_history DO NOTHING`
+	_, err := db.SQL.Exec(stmt,
+		allowance.Grantee, allowance.Granter, allowance.Height, allowance.Status, allowance.TxHash)
 
 	if err != nil {
 		return fmt.Errorf("error while deleting grant allowance: %s", err)
 	}
 	return nil
 }
+
+// DeleteExpiredFeeGrantAllowances removes all the fee grant allowances that are expired at the given time,
+// storing their expiration inside the fee grant allowance history
+func (db *Db) DeleteExpiredFeeGrantAllowances(timestamp time.Time, height int64) error {
+	stmt := `
+WITH removed AS (
+    DELETE FROM fee_grant_allowance WHERE expiration <= $1 AND height <= $2
+    RETURNING grantee_address, granter_address, allowance
+)
+INSERT INTO fee_grant_allowance_history (grantee_address, granter_address, allowance, status, height)
+SELECT grantee_address, granter_address, allowance, $3, $2 FROM removed
+ON CONFLICT ON CONSTRAINT unique_fee_grant_allowance_history DO NOTHING`
+	_, err := db.SQL.Exec(stmt, timestamp, height, types.FeeGrantStatusExpired)
+	if err != nil {
+		return fmt.Errorf("error while deleting expired grant allowances: %s", err)
+	}
+
+	return nil
+}
+
+// SaveFeeGrantUsage stores the fees paid by a fee grant allowance inside a transaction
+func (db *Db) SaveFeeGrantUsage(usage types.FeeGrantUsage) error {
+	// Store the accounts
+	var accounts []types.Account
+	accounts = append(accounts, types.NewAccount(usage.Granter), types.NewAccount(usage.Grantee))
+	err := db.SaveAccounts(accounts)
+	if err != nil {
+		return fmt.Errorf("error while storing fee grant usage accounts: %s", err)
+	}
+
+	stmt := `
+INSERT INTO fee_grant_usage (transaction_hash, grantee_address, granter_address, fee, height) 
+VALUES ($1, $2, $3, $4, $5) 
+ON CONFLICT ON CONSTRAINT unique_fee_grant_usage DO NOTHING`
+
+	_, err = db.SQL.Exec(stmt,
+		usage.TxHash, usage.Grantee, usage.Granter, pq.Array(dbtypes.NewDbCoins(usage.Fee)), usage.Height)
+	if err != nil {
+		return fmt.Errorf("error while saving fee grant usage: %s", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package database_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
 
@@ -56,6 +58,8 @@ func (suite *DbTestSuite) TestBigDipperDb_RemoveFeeGrantAllowance() {
 	err = suite.database.DeleteFeeGrantAllowance(types.NewGrantRemoval(
 		"cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn",
 		"cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt",
+		types.FeeGrantStatusRevoked,
+		"A5B6F7C8",
 		122222,
 	))
 	suite.Require().NoError(err)
@@ -65,4 +69,86 @@ func (suite *DbTestSuite) TestBigDipperDb_RemoveFeeGrantAllowance() {
 	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM fee_grant_allowance`).Scan(&count)
 	suite.Require().NoError(err)
 	suite.Require().Equal(0, count)
+
+	var rows []dbtypes.FeeAllowanceHistoryRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM fee_grant_allowance_history`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(grantee.String(), rows[0].Grantee)
+	suite.Require().Equal(granter.String(), rows[0].Granter)
+	suite.Require().Equal(string(types.FeeGrantStatusRevoked), rows[0].Status)
+	suite.Require().Equal("A5B6F7C8", rows[0].TxHash.String)
+	suite.Require().Equal(int64(122222), rows[0].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_DeleteExpiredFeeGrantAllowances() {
+	expiration := time.Date(2022, 1, 1, 00, 00, 00, 000, time.UTC)
+	granter := suite.getAccount("cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt")
+	grantee := suite.getAccount("cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn")
+	otherGrantee := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+
+	expiringGrant, err := feegranttypes.NewGrant(granter, grantee, &feegranttypes.BasicAllowance{Expiration: &expiration})
+	suite.Require().NoError(err)
+	err = suite.database.SaveFeeGrantAllowance(types.NewFeeGrant(expiringGrant, 100))
+	suite.Require().NoError(err)
+
+	permanentGrant, err := feegranttypes.NewGrant(granter, otherGrantee, &feegranttypes.BasicAllowance{})
+	suite.Require().NoError(err)
+	err = suite.database.SaveFeeGrantAllowance(types.NewFeeGrant(permanentGrant, 100))
+	suite.Require().NoError(err)
+
+	// Remove the expired allowances
+	err = suite.database.DeleteExpiredFeeGrantAllowances(expiration, 150)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.FeeAllowanceRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM fee_grant_allowance`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(otherGrantee.String(), rows[0].Grantee)
+	suite.Require().Nil(rows[0].Expiration)
+
+	var historyRows []dbtypes.FeeAllowanceHistoryRow
+	err = suite.database.Sqlx.Select(&historyRows, `SELECT * FROM fee_grant_allowance_history`)
+	suite.Require().NoError(err)
+	suite.Require().Len(historyRows, 1)
+	suite.Require().Equal(grantee.String(), historyRows[0].Grantee)
+	suite.Require().Equal(string(types.FeeGrantStatusExpired), historyRows[0].Status)
+	suite.Require().False(historyRows[0].TxHash.Valid)
+	suite.Require().Equal(int64(150), historyRows[0].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveFeeGrantUsage() {
+	usage := types.NewFeeGrantUsage(
+		"A5B6F7C8",
+		"cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn",
+		"cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt",
+		sdk.NewCoins(sdk.NewCoin("desmos", sdk.NewInt(100))),
+		100,
+	)
+
+	err := suite.database.SaveFeeGrantUsage(usage)
+	suite.Require().NoError(err)
+
+	// Test double insertion
+	err = suite.database.SaveFeeGrantUsage(usage)
+	suite.Require().NoError(err, "storing existing fee grant usage should return no error")
+
+	var rows []dbtypes.FeeGrantUsageRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM fee_grant_usage`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("A5B6F7C8", rows[0].TxHash)
+	expectedFee := dbtypes.NewDbCoins(usage.Fee)
+	suite.Require().True(expectedFee.Equal(rows[0].Fee))
+	suite.Require().Equal(int64(100), rows[0].Height)
+
+	// Pruning the height should remove the usage
+	err = suite.database.Prune(100)
+	suite.Require().NoError(err)
+
+	rows = nil
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM fee_grant_usage`)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rows)
 }
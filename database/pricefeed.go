@@ -32,15 +32,19 @@ func (db *Db) GetTokensPriceID() ([]string, error) {
 
 // --------------------------------------------------------------------------------------------------------------------
 
-// SaveToken allows to save the given token details
+// SaveToken allows to save the given token details.
+// Already existing units are left untouched, except for their price id that is set if given
 func (db *Db) SaveToken(token types.Token) error {
-	query := `INSERT INTO token (name) VALUES ($1) ON CONFLICT DO NOTHING`
-	_, err := db.SQL.Exec(query, token.Name)
+	err := db.saveToken(token.Name, token)
 	if err != nil {
 		return err
 	}
 
-	query = `INSERT INTO token_unit (token_name, denom, exponent, aliases, price_id) VALUES `
+	if len(token.Units) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO token_unit (token_name, denom, exponent, aliases, price_id) VALUES `
 	var params []interface{}
 
 	for i, unit := range token.Units {
@@ -51,8 +55,78 @@ func (db *Db) SaveToken(token types.Token) error {
 	}
 
 	query = query[:len(query)-1] // Remove trailing ","
-	query += " ON CONFLICT DO NOTHING"
+	query += `
+ON CONFLICT (denom) DO UPDATE 
+	SET price_id = COALESCE(excluded.price_id, token_unit.price_id)`
+	_, err = db.SQL.Exec(query, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving token: %s", err)
+	}
+
+	return nil
+}
+
+// SaveTokenMetadata allows to save the given token built from the bank denom metadata.
+// If any of the token units is already stored, the units are merged inside the already existing token
+// so that manually configured tokens and their price ids are preserved
+func (db *Db) SaveTokenMetadata(token types.Token) error {
+	if len(token.Units) == 0 {
+		return nil
+	}
+
+	denoms := make([]string, len(token.Units))
+	for i, unit := range token.Units {
+		denoms[i] = unit.Denom
+	}
+
+	var names []string
+	err := db.Sqlx.Select(&names, `SELECT token_name FROM token_unit WHERE denom = ANY($1) LIMIT 1`, pq.StringArray(denoms))
+	if err != nil {
+		return fmt.Errorf("error while getting existing token name: %s", err)
+	}
+
+	name := token.Name
+	if len(names) > 0 {
+		name = names[0]
+	}
+
+	err = db.saveToken(name, token)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO token_unit (token_name, denom, exponent, aliases) VALUES `
+	var params []interface{}
+
+	for i, unit := range token.Units {
+		ui := i * 4
+		query += fmt.Sprintf("($%d,$%d,$%d,$%d),", ui+1, ui+2, ui+3, ui+4)
+		params = append(params, name, unit.Denom, unit.Exponent, pq.StringArray(unit.Aliases))
+	}
+
+	query = query[:len(query)-1] // Remove trailing ","
+	query += `
+ON CONFLICT (denom) DO UPDATE 
+	SET exponent = excluded.exponent,
+	    aliases = ARRAY(SELECT DISTINCT alias FROM UNNEST(token_unit.aliases || excluded.aliases) AS alias ORDER BY alias)`
 	_, err = db.SQL.Exec(query, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving token metadata: %s", err)
+	}
+
+	return nil
+}
+
+// saveToken stores the given token using the provided name, without overriding
+// already stored display names and symbols with empty values
+func (db *Db) saveToken(name string, token types.Token) error {
+	query := `
+INSERT INTO token (name, display_name, symbol) 
+VALUES ($1, $2, $3)
+ON CONFLICT (name) DO UPDATE 
+	SET display_name = COALESCE(excluded.display_name, token.display_name),
+	    symbol = COALESCE(excluded.symbol, token.symbol)`
+	_, err := db.SQL.Exec(query, name, dbtypes.ToNullString(token.DisplayName), dbtypes.ToNullString(token.Symbol))
 	if err != nil {
 		return fmt.Errorf("error while saving token: %s", err)
 	}
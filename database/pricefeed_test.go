@@ -1,6 +1,7 @@
 package database_test
 
 import (
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -261,3 +262,71 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveTokenPriceHistory() {
 		suite.Require().True(expected[i].Equals(row))
 	}
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveTokenMetadata() {
+	// Save a manually configured token
+	err := suite.database.SaveToken(types.NewToken("desmos", []types.TokenUnit{
+		types.NewTokenUnit("udsm", 0, []string{"microdesmos"}, ""),
+		types.NewTokenUnit("dsm", 6, nil, "desmos"),
+	}))
+	suite.Require().NoError(err)
+
+	// Save the metadata of the same token and of a new one
+	err = suite.database.SaveTokenMetadata(types.Token{
+		Name:        "udsm",
+		DisplayName: "Desmos",
+		Symbol:      "DSM",
+		Units: []types.TokenUnit{
+			types.NewTokenUnit("udsm", 0, []string{"udesmos"}, ""),
+			types.NewTokenUnit("dsm", 6, nil, ""),
+		},
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveTokenMetadata(types.Token{
+		Name:   "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		Symbol: "ATOM",
+		Units: []types.TokenUnit{
+			types.NewTokenUnit("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", 0, nil, ""),
+		},
+	})
+	suite.Require().NoError(err)
+
+	// Configured tokens should not override the metadata
+	err = suite.database.SaveToken(types.NewToken("desmos", []types.TokenUnit{
+		types.NewTokenUnit("udsm", 0, []string{"microdesmos"}, ""),
+	}))
+	suite.Require().NoError(err)
+
+	// Verify the tokens
+	var tokens []struct {
+		Name        string         `db:"name"`
+		DisplayName sql.NullString `db:"display_name"`
+		Symbol      sql.NullString `db:"symbol"`
+	}
+	err = suite.database.Sqlx.Select(&tokens, `SELECT name, display_name, symbol FROM token ORDER BY name`)
+	suite.Require().NoError(err)
+	suite.Require().Len(tokens, 2)
+	suite.Require().Equal("desmos", tokens[0].Name)
+	suite.Require().Equal("Desmos", tokens[0].DisplayName.String)
+	suite.Require().Equal("DSM", tokens[0].Symbol.String)
+	suite.Require().Equal("ATOM", tokens[1].Symbol.String)
+	suite.Require().False(tokens[1].DisplayName.Valid)
+
+	// Verify the units
+	var units []dbtypes.TokenUnitRow
+	err = suite.database.Sqlx.Select(&units, `SELECT * FROM token_unit ORDER BY denom`)
+	suite.Require().NoError(err)
+	suite.Require().Len(units, 3)
+
+	suite.Require().Equal("desmos", units[0].TokenName)
+	suite.Require().Equal("dsm", units[0].Denom)
+	suite.Require().Equal("desmos", units[0].PriceID.String)
+
+	suite.Require().Equal("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", units[1].TokenName)
+	suite.Require().False(units[1].PriceID.Valid)
+
+	suite.Require().Equal("desmos", units[2].TokenName)
+	suite.Require().Equal("udsm", units[2].Denom)
+	suite.Require().Equal([]string{"microdesmos", "udesmos"}, []string(units[2].Aliases))
+}
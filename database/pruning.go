@@ -36,6 +36,16 @@ func (db *Db) Prune(height int64) error {
 		return fmt.Errorf("error while pruning slashing: %s", err)
 	}
 
+	err = db.pruneAuthz(height)
+	if err != nil {
+		return fmt.Errorf("error while pruning authz: %s", err)
+	}
+
+	err = db.pruneFeegrant(height)
+	if err != nil {
+		return fmt.Errorf("error while pruning feegrant: %s", err)
+	}
+
 	return nil
 }
 
@@ -44,6 +54,12 @@ func (db *Db) pruneBank(height int64) error {
 	if err != nil {
 		return fmt.Errorf("error while pruning supply: %s", err)
 	}
+
+	_, err = db.SQL.Exec(`DELETE FROM token_transfer WHERE height = $1`, height)
+	if err != nil {
+		return fmt.Errorf("error while pruning token transfers: %s", err)
+	}
+
 	return nil
 }
 
@@ -83,7 +99,11 @@ func (db *Db) pruneStaking(height int64) error {
 
 func (db *Db) pruneMint(height int64) error {
 	_, err := db.SQL.Exec(`DELETE FROM inflation WHERE height = $1`, height)
-	return fmt.Errorf("error while pruning inflation: %s", err)
+	if err != nil {
+		return fmt.Errorf("error while pruning inflation: %s", err)
+	}
+
+	return nil
 }
 
 func (db *Db) pruneDistribution(height int64) error {
@@ -108,3 +128,21 @@ func (db *Db) pruneSlashing(height int64) error {
 
 	return nil
 }
+
+func (db *Db) pruneAuthz(height int64) error {
+	_, err := db.SQL.Exec(`DELETE FROM authz_grant_usage WHERE height = $1`, height)
+	if err != nil {
+		return fmt.Errorf("error while pruning authz grant usages: %s", err)
+	}
+
+	return nil
+}
+
+func (db *Db) pruneFeegrant(height int64) error {
+	_, err := db.SQL.Exec(`DELETE FROM fee_grant_usage WHERE height = $1`, height)
+	if err != nil {
+		return fmt.Errorf("error while pruning fee grant usages: %s", err)
+	}
+
+	return nil
+}
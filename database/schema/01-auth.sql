@@ -1,7 +1,23 @@
 CREATE TABLE account
 (
-    address TEXT NOT NULL PRIMARY KEY
+    address            TEXT NOT NULL PRIMARY KEY,
+
+    /* The following values are empty for accounts that have not been queried yet */
+    type               TEXT,
+    pub_key_type       TEXT,
+    pub_key            JSONB,
+    account_number     BIGINT,
+    sequence           BIGINT,
+
+    /* Only set for module accounts */
+    module_name        TEXT,
+    module_permissions TEXT[],
+
+    first_seen_height  BIGINT,
+    last_seen_height   BIGINT
 );
+CREATE INDEX account_type_index ON account (type);
+CREATE INDEX account_pub_key_type_index ON account (pub_key_type);
 
 /* ---- Moved from bank.sql for vesting account usage ---- */
 CREATE TYPE COIN AS
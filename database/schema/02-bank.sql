@@ -7,4 +7,217 @@ CREATE TABLE supply
     height     BIGINT  NOT NULL,
     CHECK (one_row_id)
 );
-CREATE INDEX supply_height_index ON supply (height);
\ No newline at end of file
+CREATE INDEX supply_height_index ON supply (height);
+
+/*
+ * This holds the total supply of each denom for every hourly or daily bucket,
+ * based on the configured supply history interval.
+ * The timestamp is the beginning of the bucket, while the height is the one the supply was read at.
+ */
+CREATE TABLE supply_history
+(
+    denom     TEXT                        NOT NULL,
+    amount    DECIMAL                     NOT NULL,
+    timestamp TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    height    BIGINT                      NOT NULL,
+    CONSTRAINT unique_supply_history UNIQUE (denom, timestamp)
+);
+CREATE INDEX supply_history_timestamp_index ON supply_history (timestamp);
+CREATE INDEX supply_history_height_index ON supply_history (height);
+
+/* ---- ACCOUNT BALANCE ---- */
+
+CREATE TABLE account_balance
+(
+    address TEXT   NOT NULL REFERENCES account (address) PRIMARY KEY,
+    coins   COIN[] NOT NULL DEFAULT '{}',
+    height  BIGINT NOT NULL
+);
+CREATE INDEX account_balance_height_index ON account_balance (height);
+
+
+/* ---- TOKEN TRANSFER ---- */
+
+/*
+ * This holds one row for each denom transferred by a MsgSend or by a single output of a MsgMultiSend.
+ * The leg_index is the position of the transfer inside the message (or inside the MsgExec, for authz messages).
+ */
+CREATE TABLE token_transfer
+(
+    transaction_hash TEXT    NOT NULL,
+    msg_index        BIGINT  NOT NULL,
+    leg_index        BIGINT  NOT NULL,
+    from_address     TEXT    NOT NULL,
+    to_address       TEXT    NOT NULL,
+    denom            TEXT    NOT NULL,
+    amount           DECIMAL NOT NULL,
+    height           BIGINT  NOT NULL,
+    CONSTRAINT unique_token_transfer UNIQUE (transaction_hash, msg_index, leg_index, denom)
+);
+CREATE INDEX token_transfer_transaction_hash_index ON token_transfer (transaction_hash);
+CREATE INDEX token_transfer_from_address_denom_index ON token_transfer (from_address, denom, height);
+CREATE INDEX token_transfer_to_address_denom_index ON token_transfer (to_address, denom, height);
+CREATE INDEX token_transfer_height_index ON token_transfer (height);
+
+/* ---- CIRCULATING SUPPLY ---- */
+
+/*
+ * The circulating supply is the total supply minus the unvested amounts, the community pool,
+ * the configured module accounts balances and the configured excluded addresses balances.
+ */
+CREATE TABLE circulating_supply
+(
+    one_row_id BOOLEAN NOT NULL DEFAULT TRUE PRIMARY KEY,
+    coins      COIN[]  NOT NULL,
+    height     BIGINT  NOT NULL,
+    CHECK (one_row_id)
+);
+CREATE INDEX circulating_supply_height_index ON circulating_supply (height);
+
+/*
+ * This holds the circulating supply of each denom using the same buckets of the supply_history table.
+ */
+CREATE TABLE circulating_supply_history
+(
+    denom     TEXT                        NOT NULL,
+    amount    DECIMAL                     NOT NULL,
+    timestamp TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    height    BIGINT                      NOT NULL,
+    CONSTRAINT unique_circulating_supply_history UNIQUE (denom, timestamp)
+);
+CREATE INDEX circulating_supply_history_timestamp_index ON circulating_supply_history (timestamp);
+CREATE INDEX circulating_supply_history_height_index ON circulating_supply_history (height);
+
+/* ---- VESTING AMOUNTS ---- */
+
+/*
+ * This table is never populated, and is only used as the return type of the vesting amounts functions
+ * so that they can be tracked by Hasura.
+ */
+CREATE TABLE vesting_account_amount
+(
+    denom            TEXT    NOT NULL,
+    original_vesting DECIMAL NOT NULL,
+    vested           DECIMAL NOT NULL,
+    locked           DECIMAL NOT NULL,
+    spendable        DECIMAL NOT NULL
+);
+
+/*
+ * This function divides the given non negative dividend by the given positive divisor,
+ * rounding the quotient to the nearest integer with ties going to the even one (bankers rounding),
+ * which is how the SDK rounds decimal values.
+ */
+CREATE FUNCTION div_round_half_even(dividend DECIMAL, divisor DECIMAL)
+    RETURNS DECIMAL
+AS
+$$
+SELECT CASE
+           WHEN 2 * MOD(dividend, divisor) > divisor THEN DIV(dividend, divisor) + 1
+           WHEN 2 * MOD(dividend, divisor) = divisor THEN DIV(dividend, divisor) + MOD(DIV(dividend, divisor), 2)
+           ELSE DIV(dividend, divisor)
+           END
+$$ LANGUAGE sql IMMUTABLE;
+
+/*
+ * This function computes, for each denom, the original vesting, vested, locked and spendable amounts
+ * of the vesting account having the given address at the given time, following the SDK vesting semantics.
+ * Since delegated vesting amounts are not stored, the locked amount is the amount still vesting
+ * and the spendable amount is the stored balance minus the locked amount.
+ */
+CREATE FUNCTION vesting_account_amounts(account_address TEXT, at_time TIMESTAMP WITHOUT TIME ZONE)
+    RETURNS SETOF vesting_account_amount
+AS
+$$
+WITH account AS (
+    SELECT vesting_account.id,
+           vesting_account.type,
+           vesting_account.original_vesting,
+           vesting_account.start_time,
+           vesting_account.end_time
+    FROM vesting_account
+    WHERE vesting_account.address = account_address
+),
+     original AS (
+         SELECT (coin).denom AS denom, (coin).amount::DECIMAL AS amount
+         FROM account,
+              UNNEST(account.original_vesting) AS coin
+     ),
+     period AS (
+         SELECT vesting_period.amount,
+                account.start_time +
+                (SUM(vesting_period.length) OVER (ORDER BY vesting_period.period_order))::DOUBLE PRECISION *
+                INTERVAL '1 second' AS end_time
+         FROM vesting_period
+                  JOIN account ON vesting_period.vesting_account_id = account.id
+     ),
+     /* Amounts of the periods that have ended before the given time */
+     period_vested AS (
+         SELECT (coin).denom AS denom, SUM((coin).amount::DECIMAL) AS amount
+         FROM period,
+              UNNEST(period.amount) AS coin
+         WHERE period.end_time <= at_time
+         GROUP BY (coin).denom
+     ),
+     vested AS (
+         SELECT original.denom AS denom,
+                CASE
+                    WHEN account.type = 'cosmos.vesting.v1beta1.PermanentLockedAccount' THEN 0
+                    WHEN account.type = 'cosmos.vesting.v1beta1.DelayedVestingAccount' THEN
+                        CASE WHEN at_time >= account.end_time THEN original.amount ELSE 0 END
+                    WHEN account.type = 'cosmos.vesting.v1beta1.PeriodicVestingAccount' THEN
+                        CASE
+                            WHEN at_time <= account.start_time THEN 0
+                            WHEN at_time >= account.end_time THEN original.amount
+                            ELSE COALESCE(period_vested.amount, 0)
+                            END
+                    /*
+                     * Continuous vesting accounts, including the base vesting accounts stored by older versions.
+                     * As in the SDK, the vested ratio is rounded to 18 decimals and the vested amount to an integer
+                     */
+                    ELSE
+                        CASE
+                            WHEN at_time <= account.start_time THEN 0
+                            WHEN at_time >= account.end_time THEN original.amount
+                            ELSE div_round_half_even(
+                                    original.amount * div_round_half_even(
+                                            EXTRACT(EPOCH FROM date_trunc('second', at_time) - account.start_time)::DECIMAL *
+                                            1e18,
+                                            EXTRACT(EPOCH FROM account.end_time - account.start_time)::DECIMAL),
+                                    1e18)
+                            END
+                    END AS amount
+         FROM account
+                  CROSS JOIN original
+                  LEFT JOIN period_vested ON period_vested.denom = original.denom
+     ),
+     balance AS (
+         SELECT (coin).denom AS denom, (coin).amount::DECIMAL AS amount
+         FROM account_balance,
+              UNNEST(account_balance.coins) AS coin
+         WHERE account_balance.address = account_address
+     )
+SELECT original.denom,
+       original.amount,
+       vested.amount,
+       original.amount - vested.amount,
+       GREATEST(COALESCE(balance.amount, 0) - (original.amount - vested.amount), 0)
+FROM original
+         JOIN vested ON vested.denom = original.denom
+         LEFT JOIN balance ON balance.denom = original.denom
+ORDER BY original.denom
+$$ LANGUAGE sql STABLE;
+
+/*
+ * This function computes the vesting amounts of the vesting account having the given address
+ * at the timestamp of the block having the given height. No amounts are returned if such block is not stored.
+ */
+CREATE FUNCTION vesting_account_amounts_at_height(account_address TEXT, at_height BIGINT)
+    RETURNS SETOF vesting_account_amount
+AS
+$$
+SELECT amounts.*
+FROM block,
+     vesting_account_amounts(account_address, block.timestamp) AS amounts
+WHERE block.height = at_height
+$$ LANGUAGE sql STABLE;
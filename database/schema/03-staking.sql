@@ -49,6 +49,20 @@ CREATE TABLE validator_description
     height            BIGINT NOT NULL
 );
 CREATE INDEX validator_description_height_index ON validator_description (height);
+CREATE INDEX validator_description_identity_index ON validator_description (identity);
+
+/*
+ * This holds the avatar URLs resolved from the validators identity, along with the resolver that found them.
+ * The entries older than the configured TTL are resolved again periodically.
+ */
+CREATE TABLE validator_avatar_cache
+(
+    identity   TEXT                        NOT NULL PRIMARY KEY,
+    avatar_url TEXT                        NOT NULL,
+    resolver   TEXT                        NOT NULL,
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL
+);
+CREATE INDEX validator_avatar_cache_updated_at_index ON validator_avatar_cache (updated_at);
 
 CREATE TABLE validator_commission
 (
@@ -76,6 +90,130 @@ CREATE TABLE validator_status
 );
 CREATE INDEX validator_status_height_index ON validator_status (height);
 
+/* ---- VALIDATORS HISTORY ---- */
+
+/*
+ * These hold the history of the validators voting power, status and commission.
+ * A new row is added only when the value changes, and old rows are removed based on the configured retention.
+ */
+CREATE TABLE validator_voting_power_history
+(
+    validator_address TEXT   NOT NULL REFERENCES validator (consensus_address),
+    voting_power      BIGINT NOT NULL,
+    height            BIGINT NOT NULL,
+    CONSTRAINT unique_validator_voting_power_history UNIQUE (validator_address, height)
+);
+CREATE INDEX validator_voting_power_history_height_index ON validator_voting_power_history (height);
+
+CREATE TABLE validator_status_history
+(
+    validator_address TEXT    NOT NULL REFERENCES validator (consensus_address),
+    status            INT     NOT NULL,
+    jailed            BOOLEAN NOT NULL,
+    height            BIGINT  NOT NULL,
+    CONSTRAINT unique_validator_status_history UNIQUE (validator_address, height)
+);
+CREATE INDEX validator_status_history_height_index ON validator_status_history (height);
+
+CREATE TABLE validator_commission_history
+(
+    validator_address   TEXT    NOT NULL REFERENCES validator (consensus_address),
+    commission          DECIMAL NOT NULL,
+    min_self_delegation BIGINT  NOT NULL,
+    height              BIGINT  NOT NULL,
+    CONSTRAINT unique_validator_commission_history UNIQUE (validator_address, height)
+);
+CREATE INDEX validator_commission_history_height_index ON validator_commission_history (height);
+
+/* ---- VALIDATORS DELEGATION STATS ---- */
+
+/*
+ * This holds the latest self delegation and delegators count of each validator.
+ * The self delegation ratio is the ratio between the self delegated amount and the min self delegation,
+ * so that a validator whose ratio approaches 1 is about to be jailed.
+ */
+CREATE TABLE validator_delegation_stats
+(
+    validator_address     TEXT    NOT NULL REFERENCES validator (consensus_address) PRIMARY KEY,
+    self_delegation       NUMERIC NOT NULL,
+    min_self_delegation   BIGINT  NOT NULL,
+    self_delegation_ratio DECIMAL,
+    delegators_count      INT     NOT NULL,
+    height                BIGINT  NOT NULL
+);
+CREATE INDEX validator_delegation_stats_height_index ON validator_delegation_stats (height);
+
+/* This holds the history of the validators delegation stats, adding a new row only when the stats change */
+CREATE TABLE validator_delegation_stats_history
+(
+    validator_address     TEXT    NOT NULL REFERENCES validator (consensus_address),
+    self_delegation       NUMERIC NOT NULL,
+    min_self_delegation   BIGINT  NOT NULL,
+    self_delegation_ratio DECIMAL,
+    delegators_count      INT     NOT NULL,
+    height                BIGINT  NOT NULL,
+    CONSTRAINT unique_validator_delegation_stats_history UNIQUE (validator_address, height)
+);
+CREATE INDEX validator_delegation_stats_history_height_index ON validator_delegation_stats_history (height);
+
+/* ---- DELEGATIONS ---- */
+
+/*
+ * This holds the current delegations made by each account to each validator.
+ * It should be updated on a MESSAGE basis, refreshing only the delegations affected by each message.
+ */
+CREATE TABLE delegation
+(
+    delegator_address TEXT    NOT NULL REFERENCES account (address),
+    validator_address TEXT    NOT NULL REFERENCES validator (consensus_address),
+    shares            NUMERIC NOT NULL,
+    amount            COIN    NOT NULL,
+    height            BIGINT  NOT NULL,
+    CONSTRAINT unique_delegation UNIQUE (delegator_address, validator_address)
+);
+CREATE INDEX delegation_validator_address_index ON delegation (validator_address);
+CREATE INDEX delegation_height_index ON delegation (height);
+
+/*
+ * This holds the unbonding delegation entries that are not matured yet.
+ * It should be updated on a MESSAGE basis, and entries should be removed when they mature.
+ */
+CREATE TABLE unbonding_delegation
+(
+    delegator_address TEXT                        NOT NULL REFERENCES account (address),
+    validator_address TEXT                        NOT NULL REFERENCES validator (consensus_address),
+    creation_height   BIGINT                      NOT NULL,
+    completion_time   TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    initial_balance   NUMERIC                     NOT NULL,
+    balance           NUMERIC                     NOT NULL,
+    height            BIGINT                      NOT NULL,
+    CONSTRAINT unique_unbonding_delegation UNIQUE (delegator_address, validator_address, creation_height, completion_time)
+);
+CREATE INDEX unbonding_delegation_validator_address_index ON unbonding_delegation (validator_address);
+CREATE INDEX unbonding_delegation_completion_time_index ON unbonding_delegation (completion_time);
+CREATE INDEX unbonding_delegation_height_index ON unbonding_delegation (height);
+
+/*
+ * This holds the redelegation entries that are not matured yet.
+ * It should be updated on a MESSAGE basis, and entries should be removed when they mature.
+ */
+CREATE TABLE redelegation
+(
+    delegator_address     TEXT                        NOT NULL REFERENCES account (address),
+    src_validator_address TEXT                        NOT NULL REFERENCES validator (consensus_address),
+    dst_validator_address TEXT                        NOT NULL REFERENCES validator (consensus_address),
+    creation_height       BIGINT                      NOT NULL,
+    completion_time       TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    initial_balance       NUMERIC                     NOT NULL,
+    balance               NUMERIC                     NOT NULL,
+    height                BIGINT                      NOT NULL,
+    CONSTRAINT unique_redelegation UNIQUE (delegator_address, src_validator_address, dst_validator_address, creation_height, completion_time)
+);
+CREATE INDEX redelegation_src_validator_address_index ON redelegation (src_validator_address);
+CREATE INDEX redelegation_dst_validator_address_index ON redelegation (dst_validator_address);
+CREATE INDEX redelegation_completion_time_index ON redelegation (completion_time);
+CREATE INDEX redelegation_height_index ON redelegation (height);
+
 /* ---- DOUBLE SIGN EVIDENCE ---- */
 
 /*
@@ -105,6 +243,120 @@ CREATE TABLE double_sign_evidence
 (
     height    BIGINT NOT NULL,
     vote_a_id BIGINT NOT NULL REFERENCES double_sign_vote (id),
-    vote_b_id BIGINT NOT NULL REFERENCES double_sign_vote (id)
+    vote_b_id BIGINT NOT NULL REFERENCES double_sign_vote (id),
+    CONSTRAINT unique_double_sign_evidence UNIQUE (vote_a_id, vote_b_id)
+);
+CREATE INDEX double_sign_evidence_height_index ON double_sign_evidence (height);
+
+/* ---- LIGHT CLIENT ATTACK EVIDENCE ---- */
+
+/*
+ * This holds the light client attack evidences.
+ * It should be updated on a BLOCK basis when a light client attack occurs.
+ */
+CREATE TABLE light_client_attack_evidence
+(
+    hash                     TEXT                        NOT NULL PRIMARY KEY,
+    conflicting_block_height BIGINT                      NOT NULL,
+    conflicting_block_hash   TEXT                        NOT NULL,
+    common_height            BIGINT                      NOT NULL,
+    total_voting_power       BIGINT                      NOT NULL,
+    timestamp                TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    height                   BIGINT                      NOT NULL
+);
+CREATE INDEX light_client_attack_evidence_height_index ON light_client_attack_evidence (height);
+
+/* This holds the validators that misbehaved in creating the conflicting block of a light client attack */
+CREATE TABLE light_client_attack_byzantine_validator
+(
+    evidence_hash     TEXT   NOT NULL REFERENCES light_client_attack_evidence (hash),
+    validator_address TEXT   NOT NULL REFERENCES validator (consensus_address),
+    voting_power      BIGINT NOT NULL,
+    CONSTRAINT unique_light_client_attack_byzantine_validator UNIQUE (evidence_hash, validator_address)
+);
+CREATE INDEX light_client_attack_byzantine_validator_validator_address_index ON light_client_attack_byzantine_validator (validator_address);
+
+/*
+ * This holds the protobuf encoded evidences of the blocks whose evidences could not be stored,
+ * so that storing them is retried periodically until it succeeds.
+ */
+CREATE TABLE evidence_retry_queue
+(
+    height     BIGINT                      NOT NULL PRIMARY KEY,
+    evidences  BYTEA                       NOT NULL,
+    attempts   INT                         NOT NULL,
+    last_error TEXT                        NOT NULL,
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL
+);
+/* Log of the changes of the active validator set and of the validators jailing */
+CREATE TABLE validator_set_change
+(
+    validator_address TEXT                        NOT NULL REFERENCES validator (consensus_address),
+    type              TEXT                        NOT NULL,
+    height            BIGINT                      NOT NULL,
+    timestamp         TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    CONSTRAINT unique_validator_set_change UNIQUE (validator_address, type, height)
+);
+CREATE INDEX validator_set_change_validator_address_index ON validator_set_change (validator_address);
+CREATE INDEX validator_set_change_height_index ON validator_set_change (height);
+
+/* ---- STAKING APR ---- */
+
+/*
+ * This holds the history of the nominal staking APR, computed from the inflation, the bonded ratio
+ * and the community tax, without taking into account the validators commission.
+ */
+CREATE TABLE staking_apr
+(
+    apr           DECIMAL                     NOT NULL,
+    inflation     DECIMAL                     NOT NULL,
+    bonded_ratio  DECIMAL                     NOT NULL,
+    community_tax DECIMAL                     NOT NULL,
+    timestamp     TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    height        BIGINT                      NOT NULL PRIMARY KEY
+);
+CREATE INDEX staking_apr_timestamp_index ON staking_apr (timestamp);
+
+/* This holds the latest APR of each validator, net of its commission */
+CREATE TABLE validator_apr
+(
+    validator_address TEXT    NOT NULL REFERENCES validator (consensus_address) PRIMARY KEY,
+    commission        DECIMAL NOT NULL,
+    apr               DECIMAL NOT NULL,
+    height            BIGINT  NOT NULL
+);
+CREATE INDEX validator_apr_height_index ON validator_apr (height);
+
+/* ---- VOTING POWER DISTRIBUTION ---- */
+
+/*
+ * This holds the history of the voting power distribution among the bonded and non jailed validators.
+ * The Nakamoto coefficient is the minimum number of validators controlling more than one third of the
+ * voting power, while the top N share is the share of the voting power controlled by the top_n validators
+ * having the most voting power.
+ */
+CREATE TABLE voting_power_distribution
+(
+    validators_count     INT                         NOT NULL,
+    total_voting_power   BIGINT                      NOT NULL,
+    nakamoto_coefficient INT                         NOT NULL,
+    gini_coefficient     DECIMAL                     NOT NULL,
+    top_n                INT                         NOT NULL,
+    top_n_share          DECIMAL                     NOT NULL,
+    timestamp            TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    height               BIGINT                      NOT NULL PRIMARY KEY
+);
+CREATE INDEX voting_power_distribution_timestamp_index ON voting_power_distribution (timestamp);
+
+/* This holds the history of the share of the total voting power controlled by each validator */
+CREATE TABLE validator_voting_power_share
+(
+    validator_address TEXT    NOT NULL REFERENCES validator (consensus_address),
+    voting_power      BIGINT  NOT NULL,
+    share             DECIMAL NOT NULL,
+    rank              INT     NOT NULL,
+    height            BIGINT  NOT NULL REFERENCES voting_power_distribution (height),
+    CONSTRAINT unique_validator_voting_power_share UNIQUE (validator_address, height)
 );
-CREATE INDEX double_sign_evidence_height_index ON double_sign_evidence (height);
\ No newline at end of file
+CREATE INDEX validator_voting_power_share_validator_address_index ON validator_voting_power_share (validator_address);
+CREATE INDEX validator_voting_power_share_height_index ON validator_voting_power_share (height);
@@ -2,7 +2,11 @@
 
 CREATE TABLE token
 (
-    name TEXT NOT NULL UNIQUE
+    name         TEXT NOT NULL UNIQUE,
+
+    /* Name and symbol read from the bank denom metadata, if any */
+    display_name TEXT,
+    symbol       TEXT
 );
 
 CREATE TABLE token_unit
@@ -17,4 +17,52 @@ CREATE TABLE slashing_params
     height     BIGINT  NOT NULL,
     CHECK (one_row_id)
 );
-CREATE INDEX slashing_params_height_index ON slashing_params (height);
\ No newline at end of file
+CREATE INDEX slashing_params_height_index ON slashing_params (height);
+/* Uptime of each validator computed over the signed blocks window and over fixed time periods */
+CREATE TABLE validator_uptime
+(
+    validator_address TEXT    NOT NULL REFERENCES validator (consensus_address),
+    period            TEXT    NOT NULL,
+    start_height      BIGINT  NOT NULL,
+    signed_blocks     BIGINT  NOT NULL,
+    expected_blocks   BIGINT  NOT NULL,
+    uptime            DECIMAL NOT NULL,
+    height            BIGINT  NOT NULL,
+    PRIMARY KEY (validator_address, period)
+);
+CREATE INDEX validator_uptime_period_index ON validator_uptime (period);
+CREATE INDEX validator_uptime_height_index ON validator_uptime (height);
+
+/* Slashes applied to validators, as emitted by the slashing and evidence modules */
+CREATE TABLE validator_slash
+(
+    validator_address   TEXT    NOT NULL REFERENCES validator (consensus_address),
+    power               BIGINT  NOT NULL,
+    reason              TEXT    NOT NULL,
+    burned_tokens       NUMERIC NOT NULL,
+    jailed              BOOLEAN NOT NULL,
+    double_sign_vote_id BIGINT REFERENCES double_sign_vote (id),
+    height              BIGINT  NOT NULL,
+    CONSTRAINT unique_validator_slash UNIQUE (validator_address, reason, height)
+);
+CREATE INDEX validator_slash_validator_address_index ON validator_slash (validator_address);
+CREATE INDEX validator_slash_height_index ON validator_slash (height);
+
+/*
+ * Jailing of validators, along with the moment they have been unjailed.
+ * Unlike the signing info, this keeps the whole jail history of each validator
+ */
+CREATE TABLE validator_jail_event
+(
+    validator_address TEXT                        NOT NULL REFERENCES validator (consensus_address),
+    reason            TEXT                        NOT NULL,
+    jailed_height     BIGINT                      NOT NULL,
+    jailed_at         TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    jailed_until      TIMESTAMP WITHOUT TIME ZONE,
+    tombstoned        BOOLEAN                     NOT NULL,
+    unjailed_height   BIGINT,
+    unjailed_at       TIMESTAMP WITHOUT TIME ZONE,
+    CONSTRAINT unique_validator_jail_event UNIQUE (validator_address, jailed_height)
+);
+CREATE INDEX validator_jail_event_validator_address_index ON validator_jail_event (validator_address);
+CREATE INDEX validator_jail_event_jailed_height_index ON validator_jail_event (jailed_height);
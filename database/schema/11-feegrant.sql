@@ -4,7 +4,41 @@ CREATE TABLE fee_grant_allowance
     grantee_address    TEXT        NOT NULL REFERENCES account (address),
     granter_address    TEXT        NOT NULL REFERENCES account (address),
     allowance          JSONB       NOT NULL DEFAULT '{}'::JSONB,
+    expiration         TIMESTAMP WITHOUT TIME ZONE,
     height             BIGINT      NOT NULL,
     CONSTRAINT unique_fee_grant_allowance UNIQUE(grantee_address, granter_address) 
 );
 CREATE INDEX fee_grant_allowance_height_index ON fee_grant_allowance (height);
+CREATE INDEX fee_grant_allowance_expiration_index ON fee_grant_allowance (expiration);
+
+/*
+ * This holds all the status transitions of the fee grant allowances.
+ * The status can be one of "granted", "revoked", "expired" and "exhausted".
+ */
+CREATE TABLE fee_grant_allowance_history
+(
+    grantee_address    TEXT        NOT NULL REFERENCES account (address),
+    granter_address    TEXT        NOT NULL REFERENCES account (address),
+    allowance          JSONB       NOT NULL DEFAULT '{}'::JSONB,
+    status             TEXT        NOT NULL,
+    transaction_hash   TEXT,
+    height             BIGINT      NOT NULL,
+    CONSTRAINT unique_fee_grant_allowance_history UNIQUE (grantee_address, granter_address, status, height)
+);
+CREATE INDEX fee_grant_allowance_history_granter_index ON fee_grant_allowance_history (granter_address);
+CREATE INDEX fee_grant_allowance_history_height_index ON fee_grant_allowance_history (height);
+
+/*
+ * This holds the fees that have been paid by a fee grant allowance inside each transaction
+ */
+CREATE TABLE fee_grant_usage
+(
+    transaction_hash   TEXT        NOT NULL,
+    grantee_address    TEXT        NOT NULL REFERENCES account (address),
+    granter_address    TEXT        NOT NULL REFERENCES account (address),
+    fee                COIN[]      NOT NULL DEFAULT '{}',
+    height             BIGINT      NOT NULL,
+    CONSTRAINT unique_fee_grant_usage UNIQUE (transaction_hash)
+);
+CREATE INDEX fee_grant_usage_allowance_index ON fee_grant_usage (grantee_address, granter_address);
+CREATE INDEX fee_grant_usage_height_index ON fee_grant_usage (height);
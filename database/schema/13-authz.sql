@@ -0,0 +1,33 @@
+CREATE TABLE authz_grant
+(
+    granter_address    TEXT                        NOT NULL REFERENCES account (address),
+    grantee_address    TEXT                        NOT NULL REFERENCES account (address),
+    msg_type_url       TEXT                        NOT NULL,
+    authorization_type TEXT                        NOT NULL,
+    authorization      JSONB                       NOT NULL DEFAULT '{}'::JSONB,
+    expiration         TIMESTAMP WITHOUT TIME ZONE,
+    height             BIGINT                      NOT NULL,
+    CONSTRAINT unique_authz_grant UNIQUE (granter_address, grantee_address, msg_type_url)
+);
+CREATE INDEX authz_grant_grantee_address_index ON authz_grant (grantee_address);
+CREATE INDEX authz_grant_expiration_index ON authz_grant (expiration);
+CREATE INDEX authz_grant_height_index ON authz_grant (height);
+
+/*
+ * This holds the grants that have been used to execute the messages contained inside a MsgExec.
+ * The msg_index is the index of the MsgExec inside the transaction,
+ * while the executed_msg_index is the index of the executed message inside the MsgExec.
+ */
+CREATE TABLE authz_grant_usage
+(
+    transaction_hash   TEXT   NOT NULL,
+    msg_index          BIGINT NOT NULL,
+    executed_msg_index BIGINT NOT NULL,
+    granter_address    TEXT   NOT NULL REFERENCES account (address),
+    grantee_address    TEXT   NOT NULL REFERENCES account (address),
+    msg_type_url       TEXT   NOT NULL,
+    height             BIGINT NOT NULL,
+    CONSTRAINT unique_authz_grant_usage UNIQUE (transaction_hash, msg_index, executed_msg_index)
+);
+CREATE INDEX authz_grant_usage_grant_index ON authz_grant_usage (granter_address, grantee_address, msg_type_url);
+CREATE INDEX authz_grant_usage_height_index ON authz_grant_usage (height);
@@ -0,0 +1,16 @@
+/* Daily score of each validator, computed from the rating criteria */
+CREATE TABLE validator_score
+(
+    validator_address     TEXT                        NOT NULL REFERENCES validator (consensus_address),
+    self_delegation_ratio DECIMAL                     NOT NULL,
+    uptime                DECIMAL                     NOT NULL,
+    ever_slashed          BOOLEAN                     NOT NULL,
+    gov_participation     DECIMAL                     NOT NULL,
+    delegators_count      BIGINT                      NOT NULL,
+    score                 DECIMAL                     NOT NULL,
+    timestamp             TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    height                BIGINT                      NOT NULL,
+    CONSTRAINT unique_validator_score UNIQUE (validator_address, height)
+);
+CREATE INDEX validator_score_validator_address_index ON validator_score (validator_address);
+CREATE INDEX validator_score_height_index ON validator_score (height);
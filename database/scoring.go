@@ -0,0 +1,114 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/forbole/callisto/v4/types"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+)
+
+// SaveValidatorsScores computes the score of all the validators using the data stored inside the database
+// and the given weights, and stores it inside the validators score history.
+// Each rating criteria is normalized between 0 and 1 before being weighted:
+//   - self delegation: ratio between the self delegated shares and the total delegated shares
+//   - uptime: uptime over the signed blocks window, as periodically computed by the slashing module.
+//     This is used instead of the signing info missed blocks counter, which is reset when a validator is jailed
+//   - never slashed: 1 if the validator has never been slashed, double signed nor been tombstoned, 0 otherwise
+//   - gov participation: ratio of proposals that reached the voting period that have been voted by the validator
+//   - delegators: number of delegators relative to the validator having the most delegators
+func (db *Db) SaveValidatorsScores(weights types.ValidatorScoreWeights, timestamp time.Time, height int64) error {
+	total := weights.Total()
+	if total <= 0 {
+		return fmt.Errorf("invalid validator score weights: total weight must be positive")
+	}
+
+	stmt := `
+WITH voting_proposals AS (
+    SELECT id FROM proposal WHERE voting_start_time IS NOT NULL AND voting_start_time <= $1
+),
+inputs AS (
+    SELECT vi.consensus_address AS validator_address,
+           COALESCE(self_delegation.shares / NULLIF(delegations.shares, 0), 0) AS self_delegation_ratio,
+           COALESCE(vu.uptime, 0) AS uptime,
+           COALESCE(si.tombstoned, FALSE) OR EXISTS (
+               SELECT 1 FROM validator_slash vs WHERE vs.validator_address = vi.consensus_address
+           ) OR EXISTS (
+               SELECT 1 FROM double_sign_vote dsv
+               JOIN double_sign_evidence dse ON dse.vote_a_id = dsv.id OR dse.vote_b_id = dsv.id
+               WHERE dsv.validator_address = vi.consensus_address
+           ) AS ever_slashed,
+           COALESCE((
+               SELECT COUNT(DISTINCT pv.proposal_id) FROM proposal_vote pv 
+               WHERE pv.voter_address = vi.self_delegate_address 
+                 AND pv.proposal_id IN (SELECT id FROM voting_proposals)
+           )::DECIMAL / NULLIF((SELECT COUNT(*) FROM voting_proposals), 0), 0) AS gov_participation,
+           COALESCE(delegations.count, 0) AS delegators_count
+    FROM validator_info vi
+    LEFT JOIN validator_signing_info si ON si.validator_address = vi.consensus_address
+    LEFT JOIN validator_uptime vu ON vu.validator_address = vi.consensus_address AND vu.period = $8
+    LEFT JOIN LATERAL (
+        SELECT SUM(d.shares) AS shares, COUNT(*) AS count FROM delegation d 
+        WHERE d.validator_address = vi.consensus_address
+    ) AS delegations ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT SUM(d.shares) AS shares FROM delegation d 
+        WHERE d.validator_address = vi.consensus_address AND d.delegator_address = vi.self_delegate_address
+    ) AS self_delegation ON TRUE
+)
+INSERT INTO validator_score 
+    (validator_address, self_delegation_ratio, uptime, ever_slashed, gov_participation, delegators_count, score, timestamp, height)
+SELECT validator_address, self_delegation_ratio, uptime, ever_slashed, gov_participation, delegators_count,
+       $3 * self_delegation_ratio + 
+       $4 * uptime + 
+       $5 * (CASE WHEN ever_slashed THEN 0 ELSE 1 END) + 
+       $6 * gov_participation + 
+       $7 * COALESCE(delegators_count::DECIMAL / NULLIF(MAX(delegators_count) OVER (), 0), 0),
+       $1, $2
+FROM inputs
+ON CONFLICT ON CONSTRAINT unique_validator_score DO UPDATE 
+    SET self_delegation_ratio = excluded.self_delegation_ratio,
+        uptime = excluded.uptime,
+        ever_slashed = excluded.ever_slashed,
+        gov_participation = excluded.gov_participation,
+        delegators_count = excluded.delegators_count,
+        score = excluded.score,
+        timestamp = excluded.timestamp`
+
+	_, err := db.SQL.Exec(stmt, timestamp, height,
+		weights.SelfDelegation/total,
+		weights.Uptime/total,
+		weights.NeverSlashed/total,
+		weights.GovParticipation/total,
+		weights.Delegators/total,
+		types.UptimePeriodSignedBlocksWindow,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing validators scores: %s", err)
+	}
+
+	return nil
+}
+
+// GetValidatorsRanking returns the latest validators scores ordered by descending score,
+// skipping the first offset validators and returning at most limit of them
+func (db *Db) GetValidatorsRanking(offset uint64, limit uint64) ([]dbtypes.ValidatorRankingRow, error) {
+	stmt := `
+SELECT * FROM (
+    SELECT vs.*, vi.operator_address, RANK() OVER (ORDER BY vs.score DESC) AS rank
+    FROM validator_score vs
+    JOIN validator_info vi ON vi.consensus_address = vs.validator_address
+    WHERE vs.height = (SELECT MAX(height) FROM validator_score)
+) AS ranking
+ORDER BY rank, validator_address
+OFFSET $1 LIMIT $2`
+
+	var rows []dbtypes.ValidatorRankingRow
+	err := db.Sqlx.Select(&rows, stmt, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting validators ranking: %s", err)
+	}
+
+	return rows, nil
+}
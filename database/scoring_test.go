@@ -0,0 +1,86 @@
+package database_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveValidatorsScores() {
+	validator1 := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+	validator2 := suite.getValidator(
+		"cosmosvalcons1qq92t2l4jz5pt67tmts8ptl4p0jhr6utx5xa8y",
+		"cosmosvaloper1000ya26q2cmh399q4c5aaacd9lmmdqp90kw2jn",
+		"cosmosvalconspub1zcjduepqe93asg05nlnj30ej2pe3r8rkeryyuflhtfw3clqjphxn4j3u27msrr63nk",
+	)
+	selfDelegator := validator1.GetSelfDelegateAddress()
+	delegator := suite.getAccount("cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt").String()
+
+	coin := sdk.NewCoin("udaric", sdk.NewInt(100))
+	err := suite.database.SaveDelegations([]types.Delegation{
+		types.NewDelegation(selfDelegator, validator1.GetConsAddr(), sdk.NewDec(100), coin, 10),
+		types.NewDelegation(delegator, validator1.GetConsAddr(), sdk.NewDec(100), coin, 10),
+		types.NewDelegation(delegator, validator2.GetConsAddr(), sdk.NewDec(100), coin, 10),
+	})
+	suite.Require().NoError(err)
+
+	// The missed blocks counter is reset on jailing, so the uptime should be read from the validators uptime
+	err = suite.database.SaveValidatorsSigningInfos([]types.ValidatorSigningInfo{
+		types.NewValidatorSigningInfo(validator1.GetConsAddr(), 1, 0, time.Unix(0, 0).UTC(), false, 0, 10),
+		types.NewValidatorSigningInfo(validator2.GetConsAddr(), 1, 0, time.Unix(0, 0).UTC(), true, 0, 10),
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`
+INSERT INTO validator_uptime (validator_address, period, start_height, signed_blocks, expected_blocks, uptime, height) 
+VALUES ($1, $3, 1, 90, 100, 0.9, 10), ($2, $3, 1, 100, 100, 1, 10), ($2, $4, 1, 50, 100, 0.5, 10)`,
+		validator1.GetConsAddr(), validator2.GetConsAddr(),
+		types.UptimePeriodSignedBlocksWindow, types.UptimePeriodDay,
+	)
+	suite.Require().NoError(err)
+
+	timestamp := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = suite.database.SaveValidatorsScores(types.NewValidatorScoreWeights(1, 1, 1, 1, 1), timestamp, 10)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ValidatorScoreRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM validator_score ORDER BY score DESC`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+
+	suite.Require().Equal(validator1.GetConsAddr(), rows[0].ValidatorAddress)
+	suite.Require().InDelta(0.5, rows[0].SelfDelegationRatio, 1e-9)
+	suite.Require().InDelta(0.9, rows[0].Uptime, 1e-9)
+	suite.Require().False(rows[0].EverSlashed)
+	suite.Require().Zero(rows[0].GovParticipation)
+	suite.Require().Equal(int64(2), rows[0].DelegatorsCount)
+	suite.Require().InDelta(0.68, rows[0].Score, 1e-9)
+
+	suite.Require().Equal(validator2.GetConsAddr(), rows[1].ValidatorAddress)
+	suite.Require().Zero(rows[1].SelfDelegationRatio)
+	suite.Require().InDelta(1, rows[1].Uptime, 1e-9)
+	suite.Require().True(rows[1].EverSlashed)
+	suite.Require().Equal(int64(1), rows[1].DelegatorsCount)
+	suite.Require().InDelta(0.3, rows[1].Score, 1e-9)
+
+	// Verify the ranking
+	ranking, err := suite.database.GetValidatorsRanking(0, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(ranking, 2)
+	suite.Require().Equal(int64(1), ranking[0].Rank)
+	suite.Require().Equal(validator1.GetOperator(), ranking[0].OperatorAddress)
+	suite.Require().Equal(int64(2), ranking[1].Rank)
+	suite.Require().Equal(validator2.GetOperator(), ranking[1].OperatorAddress)
+
+	ranking, err = suite.database.GetValidatorsRanking(1, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(ranking, 1)
+	suite.Require().Equal(validator2.GetConsAddr(), ranking[0].ValidatorAddress)
+}
@@ -3,8 +3,14 @@ package database
 import (
 	"encoding/json"
 	"fmt"
+	"time"
+
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/lib/pq"
 
 	"github.com/forbole/callisto/v4/types"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
 )
 
 // SaveValidatorsSigningInfos saves the given infos inside the database
@@ -71,3 +77,245 @@ WHERE slashing_params.height <= excluded.height`
 
 	return nil
 }
+
+// GetLastSignedBlock returns the height and timestamp of the latest block for which the commit signatures
+// have been stored inside the database
+func (db *Db) GetLastSignedBlock() (dbtypes.BlockHeightAndTimestamp, error) {
+	stmt := `SELECT height, timestamp FROM block WHERE height = (SELECT MAX(height) FROM pre_commit)`
+
+	var blocks []dbtypes.BlockHeightAndTimestamp
+	if err := db.Sqlx.Select(&blocks, stmt); err != nil {
+		return dbtypes.BlockHeightAndTimestamp{}, fmt.Errorf("error while getting last signed block: %s", err)
+	}
+
+	if len(blocks) == 0 {
+		return dbtypes.BlockHeightAndTimestamp{}, nil
+	}
+
+	return blocks[0], nil
+}
+
+// validatorsBondedPeriodsStmt selects, for each validator, the height ranges [height, next_height) in which it
+// was bonded according to its status history, considering only the changes that happened up to $3
+const validatorsBondedPeriodsStmt = `
+SELECT validator_address, height, next_height FROM (
+    SELECT validator_address, height, status,
+           LEAD(height) OVER (PARTITION BY validator_address ORDER BY height) AS next_height
+    FROM validator_status_history
+    WHERE height <= $3
+) AS status_changes
+WHERE status = 3 AND (next_height IS NULL OR next_height > $2)`
+
+// SaveValidatorsUptime computes the uptime of all the validators between the given start and end heights,
+// and stores it inside the database as the uptime for the given period.
+// The expected blocks of a validator are the ones in which it was bonded, based on its status history,
+// while the signed blocks are the ones for which its pre-commit has been stored.
+func (db *Db) SaveValidatorsUptime(period types.UptimePeriod, startHeight int64, endHeight int64) error {
+	stmt := fmt.Sprintf(`
+WITH bonded_periods AS (%s),
+expected AS (
+    SELECT validator_address, 
+           SUM(LEAST(COALESCE(next_height - 1, $3), $3) - GREATEST(height, $2) + 1) AS expected_blocks
+    FROM bonded_periods
+    GROUP BY validator_address
+),
+signed AS (
+    SELECT p.validator_address, COUNT(DISTINCT p.height) AS signed_blocks
+    FROM pre_commit p
+    JOIN bonded_periods b ON b.validator_address = p.validator_address 
+        AND p.height >= b.height AND (b.next_height IS NULL OR p.height < b.next_height)
+    WHERE p.height BETWEEN $2 AND $3
+    GROUP BY p.validator_address
+)
+INSERT INTO validator_uptime (validator_address, period, start_height, signed_blocks, expected_blocks, uptime, height)
+SELECT e.validator_address, $1, $2, COALESCE(s.signed_blocks, 0), e.expected_blocks, 
+       COALESCE(s.signed_blocks, 0)::DECIMAL / e.expected_blocks, $3
+FROM expected e
+LEFT JOIN signed s ON s.validator_address = e.validator_address
+ON CONFLICT (validator_address, period) DO UPDATE 
+    SET start_height = excluded.start_height,
+        signed_blocks = excluded.signed_blocks,
+        expected_blocks = excluded.expected_blocks,
+        uptime = excluded.uptime,
+        height = excluded.height
+WHERE validator_uptime.height <= excluded.height`, validatorsBondedPeriodsStmt)
+
+	_, err := db.SQL.Exec(stmt, period, startHeight, endHeight)
+	if err != nil {
+		return fmt.Errorf("error while storing validators uptime: %s", err)
+	}
+
+	// Remove the validators that were never bonded during the period
+	_, err = db.SQL.Exec(`DELETE FROM validator_uptime WHERE period = $1 AND height < $2`, period, endHeight)
+	if err != nil {
+		return fmt.Errorf("error while deleting outdated validators uptime: %s", err)
+	}
+
+	return nil
+}
+
+// GetValidatorMissedBlocks returns the heights between the given start and end heights in which the validator
+// having the given consensus address was bonded but its pre-commit has not been stored
+func (db *Db) GetValidatorMissedBlocks(consAddr string, startHeight int64, endHeight int64) ([]int64, error) {
+	stmt := fmt.Sprintf(`
+WITH bonded_periods AS (%s)
+SELECT b.height FROM block b
+JOIN bonded_periods bp ON bp.validator_address = $1 
+    AND b.height >= bp.height AND (bp.next_height IS NULL OR b.height < bp.next_height)
+WHERE b.height BETWEEN $2 AND $3 AND NOT EXISTS (
+    SELECT 1 FROM pre_commit p WHERE p.validator_address = $1 AND p.height = b.height
+)
+ORDER BY b.height`, validatorsBondedPeriodsStmt)
+
+	var heights []int64
+	err := db.Sqlx.Select(&heights, stmt, consAddr, startHeight, endHeight)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting validator missed blocks: %s", err)
+	}
+
+	return heights, nil
+}
+
+// SaveValidatorSlashes stores the given slashes inside the database,
+// linking the double sign ones to the evidence stored at the same height, if any
+func (db *Db) SaveValidatorSlashes(slashes []types.ValidatorSlash) error {
+	if len(slashes) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO validator_slash (validator_address, power, reason, burned_tokens, jailed, height) 
+VALUES `
+	var args []interface{}
+
+	for i, slash := range slashes {
+		si := i * 6
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d),", si+1, si+2, si+3, si+4, si+5, si+6)
+		args = append(args,
+			slash.ValidatorAddress, slash.Power, slash.Reason, slash.BurnedTokens.String(), slash.Jailed, slash.Height,
+		)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT ON CONSTRAINT unique_validator_slash DO UPDATE 
+	SET power = excluded.power,
+		burned_tokens = excluded.burned_tokens,
+		jailed = excluded.jailed`
+
+	_, err := db.SQL.Exec(stmt, args...)
+	if err != nil {
+		return fmt.Errorf("error while storing validator slashes: %s", err)
+	}
+
+	heights := make([]int64, len(slashes))
+	for index, slash := range slashes {
+		heights[index] = slash.Height
+	}
+
+	return db.linkDoubleSignSlashes(heights)
+}
+
+// linkDoubleSignSlashes links the double sign slashes stored at the given heights to the double sign vote
+// of the evidence that caused them. Since slashes and evidences are stored independently,
+// this is called after storing any of them
+func (db *Db) linkDoubleSignSlashes(heights []int64) error {
+	stmt := `
+UPDATE validator_slash vs 
+SET double_sign_vote_id = dsv.id
+FROM double_sign_evidence dse
+JOIN double_sign_vote dsv ON dsv.id = dse.vote_a_id
+WHERE vs.height = ANY($1) 
+  AND vs.reason = $2 
+  AND vs.double_sign_vote_id IS NULL
+  AND dse.height = vs.height 
+  AND dsv.validator_address = vs.validator_address`
+
+	_, err := db.SQL.Exec(stmt, pq.Int64Array(heights), slashingtypes.AttributeValueDoubleSign)
+	if err != nil {
+		return fmt.Errorf("error while linking double sign slashes: %s", err)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveValidatorJailEvents stores the given validator jail events inside the database
+func (db *Db) SaveValidatorJailEvents(events []types.ValidatorJailEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO validator_jail_event (validator_address, reason, jailed_height, jailed_at, jailed_until, tombstoned) 
+VALUES `
+	var args []interface{}
+
+	for i, event := range events {
+		ei := i * 6
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d),", ei+1, ei+2, ei+3, ei+4, ei+5, ei+6)
+		args = append(args,
+			event.ValidatorAddress, event.Reason, event.JailedHeight, event.JailedAt, event.JailedUntil, event.Tombstoned,
+		)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT ON CONSTRAINT unique_validator_jail_event DO UPDATE 
+	SET reason = excluded.reason,
+		jailed_at = excluded.jailed_at,
+		jailed_until = COALESCE(excluded.jailed_until, validator_jail_event.jailed_until),
+		tombstoned = excluded.tombstoned OR validator_jail_event.tombstoned`
+
+	_, err := db.SQL.Exec(stmt, args...)
+	if err != nil {
+		return fmt.Errorf("error while storing validator jail events: %s", err)
+	}
+
+	return nil
+}
+
+// SaveValidatorTombstone marks as tombstoned the jail event of the validator having the given consensus address
+// that was still open at the given height, updating the time until which the validator is jailed
+func (db *Db) SaveValidatorTombstone(consAddr string, jailedUntil time.Time, height int64) error {
+	stmt := `
+UPDATE validator_jail_event 
+SET tombstoned = true, jailed_until = $2 
+WHERE validator_address = $1 
+  AND jailed_height <= $3 
+  AND (unjailed_height IS NULL OR unjailed_height > $3) 
+  AND NOT tombstoned`
+
+	_, err := db.SQL.Exec(stmt, consAddr, jailedUntil, height)
+	if err != nil {
+		return fmt.Errorf("error while storing validator tombstone: %s", err)
+	}
+
+	return nil
+}
+
+// SaveValidatorUnjail stores the unjailing of the validator having the given consensus address,
+// closing the jail events of such validator that were open at the given height
+func (db *Db) SaveValidatorUnjail(consAddr string, height int64, timestamp time.Time) error {
+	stmt := `
+UPDATE validator_jail_event 
+SET unjailed_height = $2, unjailed_at = $3 
+WHERE validator_address = $1 AND jailed_height < $2 AND unjailed_height IS NULL`
+
+	_, err := db.SQL.Exec(stmt, consAddr, height, timestamp)
+	if err != nil {
+		return fmt.Errorf("error while storing validator unjail: %s", err)
+	}
+
+	return nil
+}
+
+// GetValidatorJailEvents returns the jail events of the validator having the given consensus address,
+// ordered by jailed height
+func (db *Db) GetValidatorJailEvents(consAddr string) ([]dbtypes.ValidatorJailEventRow, error) {
+	var rows []dbtypes.ValidatorJailEventRow
+	err := db.Sqlx.Select(&rows,
+		`SELECT * FROM validator_jail_event WHERE validator_address = $1 ORDER BY jailed_height`, consAddr)
+	return rows, err
+}
@@ -4,6 +4,10 @@ import (
 	"encoding/json"
 	"time"
 
+	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	juno "github.com/forbole/juno/v5/types"
+
 	"github.com/forbole/callisto/v4/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -221,3 +225,194 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveSlashingParams() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(slashingParams, stored)
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveValidatorsUptime() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	for height := int64(1); height <= 10; height++ {
+		suite.getBlock(height)
+	}
+
+	// The validator is bonded between heights 1-5 and from height 8 onward
+	for _, status := range []types.ValidatorStatus{
+		types.NewValidatorStatus(validator.GetConsAddr(), validator.GetConsPubKey(), 3, false, 1),
+		types.NewValidatorStatus(validator.GetConsAddr(), validator.GetConsPubKey(), 1, true, 6),
+		types.NewValidatorStatus(validator.GetConsAddr(), validator.GetConsPubKey(), 3, false, 8),
+	} {
+		err := suite.database.SaveValidatorsStatusesHistory([]types.ValidatorStatus{status})
+		suite.Require().NoError(err)
+	}
+
+	// The signature at height 7 should be ignored since the validator was not bonded
+	timestamp := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var signatures []*juno.CommitSig
+	for _, height := range []int64{1, 2, 4, 7, 8, 9} {
+		signatures = append(signatures, juno.NewCommitSig(
+			validator.GetConsAddr(), 10, 0, height, timestamp.Add(time.Duration(height)*time.Second),
+		))
+	}
+	err := suite.database.SaveCommitSignatures(signatures)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveValidatorsUptime(types.UptimePeriodSignedBlocksWindow, 1, 10)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ValidatorUptimeRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM validator_uptime`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.ValidatorUptimeRow{
+		{
+			ValidatorAddress: validator.GetConsAddr(),
+			Period:           string(types.UptimePeriodSignedBlocksWindow),
+			StartHeight:      1,
+			SignedBlocks:     5,
+			ExpectedBlocks:   8,
+			Uptime:           0.625,
+			Height:           10,
+		},
+	}, rows)
+
+	missed, err := suite.database.GetValidatorMissedBlocks(validator.GetConsAddr(), 1, 10)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{3, 5, 10}, missed)
+
+	// Validators that were never bonded during the period should not be stored
+	err = suite.database.SaveValidatorsUptime(types.UptimePeriodHour, 6, 7)
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM validator_uptime WHERE period = $1`, types.UptimePeriodHour).
+		Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Zero(count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveValidatorSlashes() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	err := suite.database.SaveValidatorSlashes([]types.ValidatorSlash{
+		types.NewValidatorSlash(validator.GetConsAddr(), 100, slashingtypes.AttributeValueMissingSignature, sdk.NewInt(10), true, 9),
+		types.NewValidatorSlash(validator.GetConsAddr(), 100, slashingtypes.AttributeValueDoubleSign, sdk.NewInt(50), true, 10),
+	})
+	suite.Require().NoError(err)
+
+	// Saving the evidence afterwards should link it to the double sign slash
+	err = suite.database.SaveDoubleSignEvidences([]types.DoubleSignEvidence{
+		types.NewDoubleSignEvidence(
+			10,
+			types.NewDoubleSignVote(
+				int(tmtypes.PrevoteType),
+				8,
+				1,
+				"A42C9492F5DE01BFA6117137102C3EF909F1A46C2F56915F542D12AC2D0A5BCA",
+				validator.GetConsAddr(),
+				1,
+				"1qwPQjPrc7DH7+f6YAE3fOkq6phDAJ60dEyhmcZ7dx2ZgGvi9DbVLsn4leYqRNA/63ZeeH5kVly8zI1jCh4iBg==",
+			),
+			types.NewDoubleSignVote(
+				int(tmtypes.PrevoteType),
+				8,
+				1,
+				"418A20D12F45FC9340BE0CD2EDB0FFA1E4316176B8CE11E123EF6CBED23C8423",
+				validator.GetConsAddr(),
+				1,
+				"A5m7SVuvZ8YNXcUfBKLgkeV+Vy5ea+7rPfzlbkEvHOPPce6B7A2CwOIbCmPSVMKUarUdta+HiyTV+IELaOYyDA==",
+			),
+		),
+	})
+	suite.Require().NoError(err)
+
+	// Saving the same slashes again should not duplicate them
+	err = suite.database.SaveValidatorSlashes([]types.ValidatorSlash{
+		types.NewValidatorSlash(validator.GetConsAddr(), 100, slashingtypes.AttributeValueDoubleSign, sdk.NewInt(50), true, 10),
+	})
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ValidatorSlashRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM validator_slash ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+
+	suite.Require().Equal(slashingtypes.AttributeValueMissingSignature, rows[0].Reason)
+	suite.Require().Equal("10", rows[0].BurnedTokens)
+	suite.Require().False(rows[0].DoubleSignVoteID.Valid)
+
+	var voteID int64
+	err = suite.database.SQL.QueryRow(`SELECT vote_a_id FROM double_sign_evidence WHERE height = 10`).Scan(&voteID)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(slashingtypes.AttributeValueDoubleSign, rows[1].Reason)
+	suite.Require().Equal("50", rows[1].BurnedTokens)
+	suite.Require().True(rows[1].Jailed)
+	suite.Require().True(rows[1].DoubleSignVoteID.Valid)
+	suite.Require().Equal(voteID, rows[1].DoubleSignVoteID.Int64)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_ValidatorJailEvents() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	jailedAt := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	jailedUntil := jailedAt.Add(10 * time.Minute)
+
+	err := suite.database.SaveValidatorJailEvents([]types.ValidatorJailEvent{
+		types.NewValidatorJailEvent(
+			validator.GetConsAddr(), slashingtypes.AttributeValueMissingSignature, 10, jailedAt, &jailedUntil, false,
+		),
+	})
+	suite.Require().NoError(err)
+
+	// Saving the same event again without the jailed until time should keep it
+	err = suite.database.SaveValidatorJailEvents([]types.ValidatorJailEvent{
+		types.NewValidatorJailEvent(
+			validator.GetConsAddr(), slashingtypes.AttributeValueMissingSignature, 10, jailedAt, nil, false,
+		),
+	})
+	suite.Require().NoError(err)
+
+	// Unjailing closes the open jail event
+	unjailedAt := jailedAt.Add(time.Hour)
+	err = suite.database.SaveValidatorUnjail(validator.GetConsAddr(), 20, unjailedAt)
+	suite.Require().NoError(err)
+
+	// Jailing the validator again opens a new jail event, which is then tombstoned
+	err = suite.database.SaveValidatorJailEvents([]types.ValidatorJailEvent{
+		types.NewValidatorJailEvent(
+			validator.GetConsAddr(), slashingtypes.AttributeValueMissingSignature, 30, jailedAt.Add(2*time.Hour), nil, false,
+		),
+	})
+	suite.Require().NoError(err)
+
+	tombstoneEnd := time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+	err = suite.database.SaveValidatorTombstone(validator.GetConsAddr(), tombstoneEnd, 35)
+	suite.Require().NoError(err)
+
+	rows, err := suite.database.GetValidatorJailEvents(validator.GetConsAddr())
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+
+	suite.Require().Equal(int64(10), rows[0].JailedHeight)
+	suite.Require().True(jailedAt.Equal(rows[0].JailedAt))
+	suite.Require().True(rows[0].JailedUntil.Valid)
+	suite.Require().True(jailedUntil.Equal(rows[0].JailedUntil.Time))
+	suite.Require().False(rows[0].Tombstoned)
+	suite.Require().Equal(int64(20), rows[0].UnjailedHeight.Int64)
+	suite.Require().True(unjailedAt.Equal(rows[0].UnjailedAt.Time))
+
+	suite.Require().Equal(int64(30), rows[1].JailedHeight)
+	suite.Require().True(rows[1].Tombstoned)
+	suite.Require().True(tombstoneEnd.Equal(rows[1].JailedUntil.Time))
+	suite.Require().False(rows[1].UnjailedHeight.Valid)
+	suite.Require().False(rows[1].UnjailedAt.Valid)
+}
@@ -0,0 +1,95 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+)
+
+// SaveStakingAPR computes the staking APR using the inflation, mint params, staking pool, distribution params
+// and supply stored inside the database, and stores it inside the staking APR history.
+// The APR is computed as inflation * (1 - community tax) / bonded ratio, where the bonded ratio is the ratio
+// between the bonded tokens and the total supply of the minted denom.
+// The APR of each validator, net of its commission, is updated as well.
+// If any of the required values has not been stored yet, nothing is saved
+func (db *Db) SaveStakingAPR(timestamp time.Time, height int64) error {
+	stmt := `
+WITH inputs AS (
+    SELECT inflation.value AS inflation,
+           (distribution_params.params ->> 'community_tax')::DECIMAL AS community_tax,
+           staking_pool.bonded_tokens::DECIMAL / NULLIF((
+               SELECT (coin).amount::DECIMAL FROM UNNEST(supply.coins) AS coin 
+               WHERE (coin).denom = mint_params.params ->> 'mint_denom'
+           ), 0) AS bonded_ratio
+    FROM inflation, mint_params, staking_pool, distribution_params, supply
+)
+INSERT INTO staking_apr (apr, inflation, bonded_ratio, community_tax, timestamp, height)
+SELECT ROUND(inflation * (1 - community_tax) / bonded_ratio, 18), inflation, ROUND(bonded_ratio, 18), community_tax, $1, $2
+FROM inputs
+WHERE bonded_ratio > 0
+ON CONFLICT (height) DO UPDATE 
+    SET apr = excluded.apr,
+        inflation = excluded.inflation,
+        bonded_ratio = excluded.bonded_ratio,
+        community_tax = excluded.community_tax,
+        timestamp = excluded.timestamp`
+
+	_, err := db.SQL.Exec(stmt, timestamp, height)
+	if err != nil {
+		return fmt.Errorf("error while storing staking apr: %s", err)
+	}
+
+	stmt = `
+INSERT INTO validator_apr (validator_address, commission, apr, height)
+SELECT validator_commission.validator_address, 
+       validator_commission.commission, 
+       ROUND(staking_apr.apr * (1 - validator_commission.commission), 18), 
+       staking_apr.height
+FROM validator_commission, staking_apr
+WHERE staking_apr.height = $1
+ON CONFLICT (validator_address) DO UPDATE 
+    SET commission = excluded.commission,
+        apr = excluded.apr,
+        height = excluded.height
+WHERE validator_apr.height <= excluded.height`
+
+	_, err = db.SQL.Exec(stmt, height)
+	if err != nil {
+		return fmt.Errorf("error while storing validators apr: %s", err)
+	}
+
+	return nil
+}
+
+// GetLatestStakingAPR returns the latest staking APR stored inside the database.
+// If no staking APR has been stored yet, nil is returned instead
+func (db *Db) GetLatestStakingAPR() (*dbtypes.StakingAPRRow, error) {
+	var rows []dbtypes.StakingAPRRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM staking_apr ORDER BY height DESC LIMIT 1`)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return &rows[0], nil
+}
+
+// GetValidatorAPR returns the latest APR of the validator having the given consensus address.
+// If no APR has been stored for such validator, nil is returned instead
+func (db *Db) GetValidatorAPR(consAddr string) (*dbtypes.ValidatorAPRRow, error) {
+	var rows []dbtypes.ValidatorAPRRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM validator_apr WHERE validator_address = $1`, consAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return &rows[0], nil
+}
@@ -0,0 +1,74 @@
+package database_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveStakingAPR() {
+	timestamp := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	// Nothing is saved until all the required values are stored
+	err := suite.database.SaveStakingAPR(timestamp, 10)
+	suite.Require().NoError(err)
+
+	apr, err := suite.database.GetLatestStakingAPR()
+	suite.Require().NoError(err)
+	suite.Require().Nil(apr)
+
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	err = suite.database.SaveInflation(sdk.NewDecWithPrec(10, 2), 10)
+	suite.Require().NoError(err)
+
+	mintParams := minttypes.DefaultParams()
+	mintParams.MintDenom = "udaric"
+	err = suite.database.SaveMintParams(types.NewMintParams(mintParams, 10))
+	suite.Require().NoError(err)
+
+	distrParams := distrtypes.DefaultParams()
+	distrParams.CommunityTax = sdk.NewDecWithPrec(2, 2)
+	err = suite.database.SaveDistributionParams(types.NewDistributionParams(distrParams, 10))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveStakingPool(types.NewPool(sdk.NewInt(500), sdk.NewInt(500), sdk.ZeroInt(), sdk.ZeroInt(), 10))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveSupply(sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(1000)), sdk.NewCoin("uatom", sdk.NewInt(10))), 10)
+	suite.Require().NoError(err)
+
+	rate := sdk.NewDecWithPrec(10, 2)
+	minSelfDelegation := sdk.NewInt(1)
+	err = suite.database.SaveValidatorCommission(
+		types.NewValidatorCommission(validator.GetOperator(), &rate, &minSelfDelegation, 10),
+	)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveStakingAPR(timestamp, 10)
+	suite.Require().NoError(err)
+
+	// APR = 0.10 * (1 - 0.02) / 0.5
+	apr, err = suite.database.GetLatestStakingAPR()
+	suite.Require().NoError(err)
+	suite.Require().NotNil(apr)
+	suite.Require().True(sdk.MustNewDecFromStr(apr.APR).Equal(sdk.NewDecWithPrec(196, 3)))
+	suite.Require().True(sdk.MustNewDecFromStr(apr.BondedRatio).Equal(sdk.NewDecWithPrec(5, 1)))
+	suite.Require().True(timestamp.Equal(apr.Timestamp))
+	suite.Require().Equal(int64(10), apr.Height)
+
+	// Validator APR = 0.196 * (1 - 0.10)
+	validatorAPR, err := suite.database.GetValidatorAPR(validator.GetConsAddr())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(validatorAPR)
+	suite.Require().True(sdk.MustNewDecFromStr(validatorAPR.APR).Equal(sdk.NewDecWithPrec(1764, 4)))
+	suite.Require().Equal(int64(10), validatorAPR.Height)
+}
@@ -0,0 +1,102 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/lib/pq"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+// SaveValidatorsDelegationStats computes the self delegation, the self delegation ratio and the delegators count
+// of the given validators, and stores them along with their history. The self delegation is computed from the
+// stored delegation shares using the given validators tokens, so that it takes into account the slashes.
+// A new history row is added only for the validators whose stats have changed
+func (db *Db) SaveValidatorsDelegationStats(validators []types.ValidatorTokens, height int64) error {
+	if len(validators) == 0 {
+		return nil
+	}
+
+	valOpers := make([]string, len(validators))
+	tokens := make([]string, len(validators))
+	delegatorShares := make([]string, len(validators))
+	for index, validator := range validators {
+		valOpers[index] = validator.OperatorAddress
+		tokens[index] = validator.Tokens.String()
+		delegatorShares[index] = validator.DelegatorShares.String()
+	}
+
+	stmt := `
+WITH validator_tokens AS (
+    SELECT * FROM UNNEST($2::TEXT[], $3::NUMERIC[], $4::NUMERIC[]) AS t(operator_address, tokens, delegator_shares)
+), stats AS (
+    SELECT validator_info.consensus_address AS validator_address,
+           COALESCE(TRUNC(SUM(delegation.shares) 
+               FILTER (WHERE delegation.delegator_address = validator_info.self_delegate_address) 
+               * validator_tokens.tokens / NULLIF(validator_tokens.delegator_shares, 0)), 0) AS self_delegation,
+           validator_commission.min_self_delegation,
+           COUNT(DISTINCT delegation.delegator_address) AS delegators_count
+    FROM validator_tokens
+    JOIN validator_info ON validator_info.operator_address = validator_tokens.operator_address
+    JOIN validator_commission ON validator_commission.validator_address = validator_info.consensus_address
+    LEFT JOIN delegation ON delegation.validator_address = validator_info.consensus_address
+    GROUP BY validator_info.consensus_address, validator_commission.min_self_delegation, 
+             validator_tokens.tokens, validator_tokens.delegator_shares
+), ratios AS (
+    SELECT stats.*, ROUND(self_delegation / NULLIF(min_self_delegation, 0), 18) AS self_delegation_ratio
+    FROM stats
+), history AS (
+    INSERT INTO validator_delegation_stats_history 
+        (validator_address, self_delegation, min_self_delegation, self_delegation_ratio, delegators_count, height)
+    SELECT ratios.validator_address, ratios.self_delegation, ratios.min_self_delegation, 
+           ratios.self_delegation_ratio, ratios.delegators_count, $1
+    FROM ratios
+    LEFT JOIN validator_delegation_stats current ON current.validator_address = ratios.validator_address
+    WHERE current.validator_address IS NULL 
+       OR current.self_delegation <> ratios.self_delegation
+       OR current.min_self_delegation <> ratios.min_self_delegation
+       OR current.delegators_count <> ratios.delegators_count
+    ON CONFLICT ON CONSTRAINT unique_validator_delegation_stats_history DO UPDATE 
+        SET self_delegation = excluded.self_delegation,
+            min_self_delegation = excluded.min_self_delegation,
+            self_delegation_ratio = excluded.self_delegation_ratio,
+            delegators_count = excluded.delegators_count
+)
+INSERT INTO validator_delegation_stats 
+    (validator_address, self_delegation, min_self_delegation, self_delegation_ratio, delegators_count, height)
+SELECT validator_address, self_delegation, min_self_delegation, self_delegation_ratio, delegators_count, $1
+FROM ratios
+ON CONFLICT (validator_address) DO UPDATE 
+    SET self_delegation = excluded.self_delegation,
+        min_self_delegation = excluded.min_self_delegation,
+        self_delegation_ratio = excluded.self_delegation_ratio,
+        delegators_count = excluded.delegators_count,
+        height = excluded.height
+WHERE validator_delegation_stats.height <= excluded.height`
+
+	_, err := db.SQL.Exec(stmt,
+		height, pq.StringArray(valOpers), pq.StringArray(tokens), pq.StringArray(delegatorShares),
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing validators delegation stats: %s", err)
+	}
+
+	return nil
+}
+
+// GetValidatorDelegationStats returns the latest delegation stats of the validator having the given
+// consensus address. If no stats have been stored for such validator, nil is returned instead
+func (db *Db) GetValidatorDelegationStats(consAddr string) (*dbtypes.ValidatorDelegationStatsRow, error) {
+	var rows []dbtypes.ValidatorDelegationStatsRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM validator_delegation_stats WHERE validator_address = $1`, consAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return &rows[0], nil
+}
@@ -0,0 +1,101 @@
+package database_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveValidatorsDelegationStats() {
+	validator1 := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+	validator2 := suite.getValidator(
+		"cosmosvalcons1qq92t2l4jz5pt67tmts8ptl4p0jhr6utx5xa8y",
+		"cosmosvaloper1000ya26q2cmh399q4c5aaacd9lmmdqp90kw2jn",
+		"cosmosvalconspub1zcjduepqe93asg05nlnj30ej2pe3r8rkeryyuflhtfw3clqjphxn4j3u27msrr63nk",
+	)
+	delegator := suite.getAccount("cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt").String()
+
+	rate := sdk.NewDecWithPrec(10, 2)
+	minSelfDelegation := sdk.NewInt(100)
+	for _, validator := range []types.Validator{validator1, validator2} {
+		err := suite.database.SaveValidatorCommission(
+			types.NewValidatorCommission(validator.GetOperator(), &rate, &minSelfDelegation, 10),
+		)
+		suite.Require().NoError(err)
+	}
+
+	err := suite.database.SaveDelegations([]types.Delegation{
+		types.NewDelegation(validator1.GetSelfDelegateAddress(), validator1.GetConsAddr(), sdk.NewDec(250), sdk.NewCoin("udaric", sdk.NewInt(250)), 10),
+		types.NewDelegation(delegator, validator1.GetConsAddr(), sdk.NewDec(100), sdk.NewCoin("udaric", sdk.NewInt(100)), 10),
+	})
+	suite.Require().NoError(err)
+
+	tokens1 := types.NewValidatorTokens(validator1.GetOperator(), sdk.NewInt(350), sdk.NewDec(350))
+	tokens2 := types.NewValidatorTokens(validator2.GetOperator(), sdk.ZeroInt(), sdk.ZeroDec())
+	err = suite.database.SaveValidatorsDelegationStats([]types.ValidatorTokens{tokens1, tokens2}, 10)
+	suite.Require().NoError(err)
+
+	stats, err := suite.database.GetValidatorDelegationStats(validator1.GetConsAddr())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(stats)
+	suite.Require().Equal("250", stats.SelfDelegation)
+	suite.Require().Equal(int64(100), stats.MinSelfDelegation)
+	suite.Require().True(stats.SelfDelegationRatio.Valid)
+	suite.Require().Equal("2.500000000000000000", stats.SelfDelegationRatio.String)
+	suite.Require().Equal(2, stats.DelegatorsCount)
+	suite.Require().Equal(int64(10), stats.Height)
+
+	// Validators without delegations have no self delegation
+	stats, err = suite.database.GetValidatorDelegationStats(validator2.GetConsAddr())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(stats)
+	suite.Require().Equal("0", stats.SelfDelegation)
+	suite.Require().Equal(0, stats.DelegatorsCount)
+
+	// Unchanged stats are not added to the history
+	err = suite.database.SaveValidatorsDelegationStats([]types.ValidatorTokens{tokens1}, 11)
+	suite.Require().NoError(err)
+
+	stats, err = suite.database.GetValidatorDelegationStats(validator1.GetConsAddr())
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(11), stats.Height)
+
+	// Changed stats are added to the history, only for the given validators
+	err = suite.database.DeleteDelegation(delegator, validator1.GetConsAddr(), 12)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveValidatorsDelegationStats([]types.ValidatorTokens{tokens1}, 12)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ValidatorDelegationStatsRow
+	err = suite.database.Sqlx.Select(&rows,
+		`SELECT * FROM validator_delegation_stats_history WHERE validator_address = $1 ORDER BY height`,
+		validator1.GetConsAddr(),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Require().Equal(int64(10), rows[0].Height)
+	suite.Require().Equal(2, rows[0].DelegatorsCount)
+	suite.Require().Equal(int64(12), rows[1].Height)
+	suite.Require().Equal(1, rows[1].DelegatorsCount)
+
+	stats, err = suite.database.GetValidatorDelegationStats(validator2.GetConsAddr())
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10), stats.Height)
+
+	// Slashes reduce the self delegation without changing the delegation shares
+	slashedTokens := types.NewValidatorTokens(validator1.GetOperator(), sdk.NewInt(315), sdk.NewDec(350))
+	err = suite.database.SaveValidatorsDelegationStats([]types.ValidatorTokens{slashedTokens}, 13)
+	suite.Require().NoError(err)
+
+	stats, err = suite.database.GetValidatorDelegationStats(validator1.GetConsAddr())
+	suite.Require().NoError(err)
+	suite.Require().Equal("225", stats.SelfDelegation)
+	suite.Require().Equal("2.250000000000000000", stats.SelfDelegationRatio.String)
+	suite.Require().Equal(int64(13), stats.Height)
+}
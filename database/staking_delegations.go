@@ -0,0 +1,258 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+// SaveDelegations allows to store the given delegations, replacing the existing ones made by the same
+// delegator to the same validator only if they are older
+func (db *Db) SaveDelegations(delegations []types.Delegation) error {
+	if len(delegations) == 0 {
+		return nil
+	}
+
+	// Store the delegators accounts
+	var accounts []types.Account
+	for _, delegation := range delegations {
+		accounts = append(accounts, types.NewAccount(delegation.DelegatorAddress))
+	}
+	err := db.SaveAccounts(accounts)
+	if err != nil {
+		return fmt.Errorf("error while storing delegators accounts: %s", err)
+	}
+
+	stmt := `INSERT INTO delegation (delegator_address, validator_address, shares, amount, height) VALUES `
+	var params []interface{}
+
+	for i, delegation := range delegations {
+		di := i * 5
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", di+1, di+2, di+3, di+4, di+5)
+
+		amount := dbtypes.NewDbCoin(delegation.Amount)
+		params = append(params, delegation.DelegatorAddress, delegation.ValidatorAddress,
+			delegation.Shares.String(), &amount, delegation.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT ON CONSTRAINT unique_delegation DO UPDATE 
+    SET shares = excluded.shares,
+        amount = excluded.amount,
+        height = excluded.height
+WHERE delegation.height <= excluded.height`
+
+	_, err = db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing delegations: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteDelegation removes the delegation made by the given delegator to the validator
+// having the given consensus address, if it is not newer than the given height
+func (db *Db) DeleteDelegation(delegatorAddress string, validatorAddress string, height int64) error {
+	stmt := `DELETE FROM delegation WHERE delegator_address = $1 AND validator_address = $2 AND height <= $3`
+	_, err := db.SQL.Exec(stmt, delegatorAddress, validatorAddress, height)
+	if err != nil {
+		return fmt.Errorf("error while deleting delegation: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteValidatorDelegationsBefore removes all the delegations made to the validator having
+// the given consensus address that have been stored before the given height
+func (db *Db) DeleteValidatorDelegationsBefore(validatorAddress string, height int64) error {
+	stmt := `DELETE FROM delegation WHERE validator_address = $1 AND height < $2`
+	_, err := db.SQL.Exec(stmt, validatorAddress, height)
+	if err != nil {
+		return fmt.Errorf("error while deleting old validator delegations: %s", err)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// ReplaceUnbondingDelegationEntries replaces all the unbonding delegation entries of the given delegator
+// from the validator having the given consensus address with the provided ones.
+// If the stored entries are newer than the given height, nothing is changed
+func (db *Db) ReplaceUnbondingDelegationEntries(
+	delegatorAddress string, validatorAddress string, entries []types.UnbondingDelegationEntry, height int64,
+) error {
+	if len(entries) > 0 {
+		err := db.SaveAccounts([]types.Account{types.NewAccount(delegatorAddress)})
+		if err != nil {
+			return fmt.Errorf("error while storing delegator account: %s", err)
+		}
+	}
+
+	// Check, delete and insert the entries atomically so that they are never partially replaced
+	tx, err := db.SQL.Begin()
+	if err != nil {
+		return fmt.Errorf("error while beginning unbonding delegation entries transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	var count int
+	err = tx.QueryRow(`
+SELECT COUNT(*) FROM unbonding_delegation WHERE delegator_address = $1 AND validator_address = $2 AND height > $3`,
+		delegatorAddress, validatorAddress, height).Scan(&count)
+	if err != nil {
+		return fmt.Errorf("error while checking unbonding delegation entries: %s", err)
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	_, err = tx.Exec(`
+DELETE FROM unbonding_delegation WHERE delegator_address = $1 AND validator_address = $2 AND height <= $3`,
+		delegatorAddress, validatorAddress, height)
+	if err != nil {
+		return fmt.Errorf("error while deleting unbonding delegation entries: %s", err)
+	}
+
+	if len(entries) == 0 {
+		return tx.Commit()
+	}
+
+	stmt := `
+INSERT INTO unbonding_delegation (delegator_address, validator_address, creation_height, completion_time, initial_balance, balance, height) 
+VALUES `
+	var params []interface{}
+
+	for i, entry := range entries {
+		ei := i * 7
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d),", ei+1, ei+2, ei+3, ei+4, ei+5, ei+6, ei+7)
+		params = append(params, entry.DelegatorAddress, entry.ValidatorAddress, entry.CreationHeight,
+			entry.CompletionTime, entry.InitialBalance.String(), entry.Balance.String(), entry.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT ON CONSTRAINT unique_unbonding_delegation DO UPDATE 
+    SET initial_balance = excluded.initial_balance,
+        balance = excluded.balance,
+        height = excluded.height
+WHERE unbonding_delegation.height <= excluded.height`
+
+	_, err = tx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing unbonding delegation entries: %s", err)
+	}
+
+	return tx.Commit()
+}
+
+// DeleteMaturedUnbondingDelegationEntries removes all the unbonding delegation entries of the given delegator
+// from the validator having the given consensus address that are completed at the given time
+func (db *Db) DeleteMaturedUnbondingDelegationEntries(
+	delegatorAddress string, validatorAddress string, timestamp time.Time,
+) error {
+	stmt := `
+DELETE FROM unbonding_delegation WHERE delegator_address = $1 AND validator_address = $2 AND completion_time <= $3`
+	_, err := db.SQL.Exec(stmt, delegatorAddress, validatorAddress, timestamp)
+	if err != nil {
+		return fmt.Errorf("error while deleting matured unbonding delegation entries: %s", err)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// ReplaceRedelegationEntries replaces all the redelegation entries of the given delegator
+// between the validators having the given consensus addresses with the provided ones.
+// If the stored entries are newer than the given height, nothing is changed
+func (db *Db) ReplaceRedelegationEntries(
+	delegatorAddress string, srcValidatorAddress string, dstValidatorAddress string,
+	entries []types.RedelegationEntry, height int64,
+) error {
+	if len(entries) > 0 {
+		err := db.SaveAccounts([]types.Account{types.NewAccount(delegatorAddress)})
+		if err != nil {
+			return fmt.Errorf("error while storing delegator account: %s", err)
+		}
+	}
+
+	// Check, delete and insert the entries atomically so that they are never partially replaced
+	tx, err := db.SQL.Begin()
+	if err != nil {
+		return fmt.Errorf("error while beginning redelegation entries transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	var count int
+	err = tx.QueryRow(`
+SELECT COUNT(*) FROM redelegation 
+WHERE delegator_address = $1 AND src_validator_address = $2 AND dst_validator_address = $3 AND height > $4`,
+		delegatorAddress, srcValidatorAddress, dstValidatorAddress, height).Scan(&count)
+	if err != nil {
+		return fmt.Errorf("error while checking redelegation entries: %s", err)
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	_, err = tx.Exec(`
+DELETE FROM redelegation 
+WHERE delegator_address = $1 AND src_validator_address = $2 AND dst_validator_address = $3 AND height <= $4`,
+		delegatorAddress, srcValidatorAddress, dstValidatorAddress, height)
+	if err != nil {
+		return fmt.Errorf("error while deleting redelegation entries: %s", err)
+	}
+
+	if len(entries) == 0 {
+		return tx.Commit()
+	}
+
+	stmt := `
+INSERT INTO redelegation (delegator_address, src_validator_address, dst_validator_address, creation_height, completion_time, initial_balance, balance, height) 
+VALUES `
+	var params []interface{}
+
+	for i, entry := range entries {
+		ei := i * 8
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),", ei+1, ei+2, ei+3, ei+4, ei+5, ei+6, ei+7, ei+8)
+		params = append(params, entry.DelegatorAddress, entry.SrcValidatorAddress, entry.DstValidatorAddress,
+			entry.CreationHeight, entry.CompletionTime, entry.InitialBalance.String(), entry.Balance.String(), entry.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT ON CONSTRAINT unique_redelegation DO UPDATE 
+    SET initial_balance = excluded.initial_balance,
+        balance = excluded.balance,
+        height = excluded.height
+WHERE redelegation.height <= excluded.height`
+
+	_, err = tx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing redelegation entries: %s", err)
+	}
+
+	return tx.Commit()
+}
+
+// DeleteMaturedRedelegationEntries removes all the redelegation entries of the given delegator between the
+// validators having the given consensus addresses that are completed at the given time
+func (db *Db) DeleteMaturedRedelegationEntries(
+	delegatorAddress string, srcValidatorAddress string, dstValidatorAddress string, timestamp time.Time,
+) error {
+	stmt := `
+DELETE FROM redelegation 
+WHERE delegator_address = $1 AND src_validator_address = $2 AND dst_validator_address = $3 AND completion_time <= $4`
+	_, err := db.SQL.Exec(stmt, delegatorAddress, srcValidatorAddress, dstValidatorAddress, timestamp)
+	if err != nil {
+		return fmt.Errorf("error while deleting matured redelegation entries: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package database_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveDelegations() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+	delegator := "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"
+
+	// Save the data
+	err := suite.database.SaveDelegations([]types.Delegation{
+		types.NewDelegation(delegator, validator.GetConsAddr(), sdk.NewDec(100), sdk.NewCoin("udaric", sdk.NewInt(100)), 10),
+	})
+	suite.Require().NoError(err)
+
+	// Update with a lower height
+	err = suite.database.SaveDelegations([]types.Delegation{
+		types.NewDelegation(delegator, validator.GetConsAddr(), sdk.NewDec(50), sdk.NewCoin("udaric", sdk.NewInt(50)), 9),
+	})
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.DelegationRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM delegation`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(sdk.NewDec(100).String(), rows[0].Shares)
+	suite.Require().True(rows[0].Amount.Equal(dbtypes.NewDbCoin(sdk.NewCoin("udaric", sdk.NewInt(100)))))
+	suite.Require().Equal(int64(10), rows[0].Height)
+
+	// Update with a higher height
+	err = suite.database.SaveDelegations([]types.Delegation{
+		types.NewDelegation(delegator, validator.GetConsAddr(), sdk.NewDec(200), sdk.NewCoin("udaric", sdk.NewInt(200)), 11),
+	})
+	suite.Require().NoError(err)
+
+	rows = nil
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM delegation`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(sdk.NewDec(200).String(), rows[0].Shares)
+	suite.Require().Equal(int64(11), rows[0].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_DeleteDelegation() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+	delegator := "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"
+
+	err := suite.database.SaveDelegations([]types.Delegation{
+		types.NewDelegation(delegator, validator.GetConsAddr(), sdk.NewDec(100), sdk.NewCoin("udaric", sdk.NewInt(100)), 10),
+	})
+	suite.Require().NoError(err)
+
+	// Removals older than the delegation should be ignored
+	err = suite.database.DeleteDelegation(delegator, validator.GetConsAddr(), 9)
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM delegation`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+
+	err = suite.database.DeleteDelegation(delegator, validator.GetConsAddr(), 10)
+	suite.Require().NoError(err)
+
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM delegation`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_ReplaceUnbondingDelegationEntries() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+	delegator := "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"
+	completionTime := time.Date(2022, 1, 1, 00, 00, 00, 000, time.UTC)
+
+	// Save the entries
+	err := suite.database.ReplaceUnbondingDelegationEntries(delegator, validator.GetConsAddr(), []types.UnbondingDelegationEntry{
+		types.NewUnbondingDelegationEntry(delegator, validator.GetConsAddr(), 10, completionTime, sdk.NewInt(100), sdk.NewInt(100), 10),
+		types.NewUnbondingDelegationEntry(delegator, validator.GetConsAddr(), 11, completionTime.Add(time.Hour), sdk.NewInt(50), sdk.NewInt(50), 11),
+	}, 11)
+	suite.Require().NoError(err)
+
+	// Replacing with older entries should be ignored
+	err = suite.database.ReplaceUnbondingDelegationEntries(delegator, validator.GetConsAddr(), nil, 10)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.UnbondingDelegationRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM unbonding_delegation ORDER BY creation_height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+
+	// Replace the entries after a cancel unbonding delegation
+	err = suite.database.ReplaceUnbondingDelegationEntries(delegator, validator.GetConsAddr(), []types.UnbondingDelegationEntry{
+		types.NewUnbondingDelegationEntry(delegator, validator.GetConsAddr(), 10, completionTime, sdk.NewInt(100), sdk.NewInt(40), 12),
+	}, 12)
+	suite.Require().NoError(err)
+
+	rows = nil
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM unbonding_delegation`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("40", rows[0].Balance)
+	suite.Require().Equal(int64(12), rows[0].Height)
+
+	// Remove the matured entries
+	err = suite.database.DeleteMaturedUnbondingDelegationEntries(delegator, validator.GetConsAddr(), completionTime)
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM unbonding_delegation`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_ReplaceRedelegationEntries() {
+	srcValidator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+	dstValidator := suite.getValidator(
+		"cosmosvalcons1rtst6se0nfgjy362v33jt5d05crgdyhfvvvvay",
+		"cosmosvaloper1jlr62guqwrwkdt4m3y00zh2rrsamhjf9num5xr",
+		"cosmosvalconspub1zcjduepq5e8w7t7k9pwfewgrwy8vn6cghk0x49chx64vt0054yl4wwsmjgrqfackxm",
+	)
+	delegator := "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"
+	completionTime := time.Date(2022, 1, 1, 00, 00, 00, 000, time.UTC)
+
+	err := suite.database.ReplaceRedelegationEntries(delegator, srcValidator.GetConsAddr(), dstValidator.GetConsAddr(), []types.RedelegationEntry{
+		types.NewRedelegationEntry(delegator, srcValidator.GetConsAddr(), dstValidator.GetConsAddr(), 10, completionTime, sdk.NewInt(100), sdk.NewInt(100), 10),
+		types.NewRedelegationEntry(delegator, srcValidator.GetConsAddr(), dstValidator.GetConsAddr(), 11, completionTime.Add(time.Hour), sdk.NewInt(50), sdk.NewInt(50), 11),
+	}, 11)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.RedelegationRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM redelegation ORDER BY creation_height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Require().Equal(srcValidator.GetConsAddr(), rows[0].SrcValidatorAddress)
+	suite.Require().Equal(dstValidator.GetConsAddr(), rows[0].DstValidatorAddress)
+	suite.Require().True(completionTime.Equal(rows[0].CompletionTime))
+
+	// Remove the matured entries
+	err = suite.database.DeleteMaturedRedelegationEntries(
+		delegator, srcValidator.GetConsAddr(), dstValidator.GetConsAddr(), completionTime,
+	)
+	suite.Require().NoError(err)
+
+	rows = nil
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM redelegation`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(int64(11), rows[0].CreationHeight)
+}
@@ -0,0 +1,113 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+// SaveLightClientAttackEvidences saves the given light client attack evidences inside the database,
+// along with the byzantine validators of each of them
+func (db *Db) SaveLightClientAttackEvidences(evidences []types.LightClientAttackEvidence) error {
+	for _, evidence := range evidences {
+		err := db.saveLightClientAttackEvidence(evidence)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveLightClientAttackEvidence saves the given light client attack evidence inside the database
+func (db *Db) saveLightClientAttackEvidence(evidence types.LightClientAttackEvidence) error {
+	stmt := `
+INSERT INTO light_client_attack_evidence 
+    (hash, conflicting_block_height, conflicting_block_hash, common_height, total_voting_power, timestamp, height) 
+VALUES ($1, $2, $3, $4, $5, $6, $7) 
+ON CONFLICT (hash) DO NOTHING`
+
+	_, err := db.SQL.Exec(stmt,
+		evidence.Hash, evidence.ConflictingBlockHeight, evidence.ConflictingBlockHash, evidence.CommonHeight,
+		evidence.TotalVotingPower, evidence.Timestamp, evidence.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing light client attack evidence: %s", err)
+	}
+
+	if len(evidence.ByzantineValidators) == 0 {
+		return nil
+	}
+
+	// Make sure the byzantine validators are stored before referencing them
+	validatorStmt := `INSERT INTO validator (consensus_address, consensus_pubkey) VALUES `
+	var valParams []interface{}
+
+	byzantineStmt := `INSERT INTO light_client_attack_byzantine_validator (evidence_hash, validator_address, voting_power) VALUES `
+	var byzantineParams []interface{}
+
+	for i, validator := range evidence.ByzantineValidators {
+		vi := i * 2
+		validatorStmt += fmt.Sprintf("($%d,$%d),", vi+1, vi+2)
+		valParams = append(valParams, validator.ConsensusAddress, validator.ConsensusPubKey)
+
+		bi := i * 3
+		byzantineStmt += fmt.Sprintf("($%d,$%d,$%d),", bi+1, bi+2, bi+3)
+		byzantineParams = append(byzantineParams, evidence.Hash, validator.ConsensusAddress, validator.VotingPower)
+	}
+
+	validatorStmt = validatorStmt[:len(validatorStmt)-1]
+	validatorStmt += " ON CONFLICT DO NOTHING"
+	_, err = db.SQL.Exec(validatorStmt, valParams...)
+	if err != nil {
+		return fmt.Errorf("error while storing byzantine validators: %s", err)
+	}
+
+	byzantineStmt = byzantineStmt[:len(byzantineStmt)-1]
+	byzantineStmt += " ON CONFLICT ON CONSTRAINT unique_light_client_attack_byzantine_validator DO NOTHING"
+	_, err = db.SQL.Exec(byzantineStmt, byzantineParams...)
+	if err != nil {
+		return fmt.Errorf("error while storing light client attack byzantine validators: %s", err)
+	}
+
+	return nil
+}
+
+// SaveEvidenceRetry stores the given protobuf encoded evidences of the block at the given height inside
+// the evidence retry queue, increasing the number of attempts if they have already been queued
+func (db *Db) SaveEvidenceRetry(height int64, evidences []byte, lastError string, timestamp time.Time) error {
+	stmt := `
+INSERT INTO evidence_retry_queue (height, evidences, attempts, last_error, updated_at) 
+VALUES ($1, $2, 1, $3, $4) 
+ON CONFLICT (height) DO UPDATE 
+    SET evidences = excluded.evidences,
+        attempts = evidence_retry_queue.attempts + 1,
+        last_error = excluded.last_error,
+        updated_at = excluded.updated_at`
+
+	_, err := db.SQL.Exec(stmt, height, evidences, lastError, timestamp)
+	if err != nil {
+		return fmt.Errorf("error while storing evidence retry: %s", err)
+	}
+
+	return nil
+}
+
+// GetEvidenceRetries returns the evidences inside the evidence retry queue that have been attempted
+// less than the given number of times, ordered by height
+func (db *Db) GetEvidenceRetries(maxAttempts int) ([]dbtypes.EvidenceRetryRow, error) {
+	var rows []dbtypes.EvidenceRetryRow
+	stmt := `SELECT * FROM evidence_retry_queue WHERE attempts < $1 ORDER BY height`
+	err := db.Sqlx.Select(&rows, stmt, maxAttempts)
+	return rows, err
+}
+
+// DeleteEvidenceRetry removes the evidences of the block at the given height from the evidence retry queue
+func (db *Db) DeleteEvidenceRetry(height int64) error {
+	_, err := db.SQL.Exec(`DELETE FROM evidence_retry_queue WHERE height = $1`, height)
+	if err != nil {
+		return fmt.Errorf("error while deleting evidence retry: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package database_test
+
+import (
+	"time"
+
+	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveDoubleSignEvidences() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	evidence := types.NewDoubleSignEvidence(
+		10,
+		types.NewDoubleSignVote(
+			int(tmtypes.PrevoteType),
+			8,
+			1,
+			"A42C9492F5DE01BFA6117137102C3EF909F1A46C2F56915F542D12AC2D0A5BCA",
+			validator.GetConsAddr(),
+			1,
+			"1qwPQjPrc7DH7+f6YAE3fOkq6phDAJ60dEyhmcZ7dx2ZgGvi9DbVLsn4leYqRNA/63ZeeH5kVly8zI1jCh4iBg==",
+		),
+		types.NewDoubleSignVote(
+			int(tmtypes.PrevoteType),
+			8,
+			1,
+			"418A20D12F45FC9340BE0CD2EDB0FFA1E4316176B8CE11E123EF6CBED23C8423",
+			validator.GetConsAddr(),
+			1,
+			"A5m7SVuvZ8YNXcUfBKLgkeV+Vy5ea+7rPfzlbkEvHOPPce6B7A2CwOIbCmPSVMKUarUdta+HiyTV+IELaOYyDA==",
+		),
+	)
+
+	err := suite.database.SaveDoubleSignEvidences([]types.DoubleSignEvidence{evidence})
+	suite.Require().NoError(err)
+
+	// Saving the same evidence again should not fail nor duplicate it
+	err = suite.database.SaveDoubleSignEvidences([]types.DoubleSignEvidence{evidence})
+	suite.Require().NoError(err)
+
+	var votesCount, evidencesCount int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM double_sign_vote`).Scan(&votesCount)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, votesCount)
+
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM double_sign_evidence`).Scan(&evidencesCount)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, evidencesCount)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveLightClientAttackEvidences() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	timestamp := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	evidence := types.NewLightClientAttackEvidence(
+		"6B3B0BF7B7D1A4A3D04C1C8D7C7BA9E8E7E93B5D8AF1C2E9A3D4B5C6D7E8F901",
+		9,
+		"418A20D12F45FC9340BE0CD2EDB0FFA1E4316176B8CE11E123EF6CBED23C8423",
+		8,
+		[]types.ByzantineValidator{
+			types.NewByzantineValidator(validator.GetConsAddr(), validator.GetConsPubKey(), 100),
+			// Validators that are not stored yet are stored along with the evidence
+			types.NewByzantineValidator(
+				"cosmosvalcons1qq92t2l4jz5pt67tmts8ptl4p0jhr6utx5xa8y",
+				"cosmosvalconspub1zcjduepqe93asg05nlnj30ej2pe3r8rkeryyuflhtfw3clqjphxn4j3u27msrr63nk",
+				50,
+			),
+		},
+		300,
+		timestamp,
+		10,
+	)
+
+	err := suite.database.SaveLightClientAttackEvidences([]types.LightClientAttackEvidence{evidence})
+	suite.Require().NoError(err)
+
+	// Saving the same evidence again should not fail nor duplicate it
+	err = suite.database.SaveLightClientAttackEvidences([]types.LightClientAttackEvidence{evidence})
+	suite.Require().NoError(err)
+
+	var hash, conflictingBlockHash string
+	var conflictingBlockHeight, commonHeight, totalVotingPower, height int64
+	var storedTimestamp time.Time
+	err = suite.database.SQL.QueryRow(`
+SELECT hash, conflicting_block_height, conflicting_block_hash, common_height, total_voting_power, timestamp, height 
+FROM light_client_attack_evidence`).Scan(
+		&hash, &conflictingBlockHeight, &conflictingBlockHash, &commonHeight, &totalVotingPower, &storedTimestamp, &height,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(evidence.Hash, hash)
+	suite.Require().Equal(int64(9), conflictingBlockHeight)
+	suite.Require().Equal(evidence.ConflictingBlockHash, conflictingBlockHash)
+	suite.Require().Equal(int64(8), commonHeight)
+	suite.Require().Equal(int64(300), totalVotingPower)
+	suite.Require().True(timestamp.Equal(storedTimestamp))
+	suite.Require().Equal(int64(10), height)
+
+	rows, err := suite.database.SQL.Query(`
+SELECT validator_address, voting_power FROM light_client_attack_byzantine_validator 
+WHERE evidence_hash = $1 ORDER BY voting_power DESC`, evidence.Hash)
+	suite.Require().NoError(err)
+	defer rows.Close()
+
+	var byzantineValidators []types.ByzantineValidator
+	for rows.Next() {
+		var byzantineValidator types.ByzantineValidator
+		err = rows.Scan(&byzantineValidator.ConsensusAddress, &byzantineValidator.VotingPower)
+		suite.Require().NoError(err)
+		byzantineValidators = append(byzantineValidators, byzantineValidator)
+	}
+	suite.Require().Equal([]types.ByzantineValidator{
+		{ConsensusAddress: validator.GetConsAddr(), VotingPower: 100},
+		{ConsensusAddress: "cosmosvalcons1qq92t2l4jz5pt67tmts8ptl4p0jhr6utx5xa8y", VotingPower: 50},
+	}, byzantineValidators)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_EvidenceRetries() {
+	timestamp := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	err := suite.database.SaveEvidenceRetry(10, []byte("evidences"), "connection refused", timestamp)
+	suite.Require().NoError(err)
+
+	// Queueing the same evidences again should increase the attempts
+	err = suite.database.SaveEvidenceRetry(10, []byte("evidences"), "timeout", timestamp.Add(time.Minute))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveEvidenceRetry(5, []byte("other evidences"), "timeout", timestamp)
+	suite.Require().NoError(err)
+
+	retries, err := suite.database.GetEvidenceRetries(5)
+	suite.Require().NoError(err)
+	suite.Require().Len(retries, 2)
+	suite.Require().Equal(int64(5), retries[0].Height)
+	suite.Require().Equal(int64(10), retries[1].Height)
+	suite.Require().Equal([]byte("evidences"), retries[1].Evidences)
+	suite.Require().Equal(2, retries[1].Attempts)
+	suite.Require().Equal("timeout", retries[1].LastError)
+	suite.Require().True(timestamp.Add(time.Minute).Equal(retries[1].UpdatedAt))
+
+	// Evidences that have reached the maximum attempts should not be returned
+	retries, err = suite.database.GetEvidenceRetries(2)
+	suite.Require().NoError(err)
+	suite.Require().Len(retries, 1)
+	suite.Require().Equal(int64(5), retries[0].Height)
+
+	err = suite.database.DeleteEvidenceRetry(10)
+	suite.Require().NoError(err)
+
+	retries, err = suite.database.GetEvidenceRetries(5)
+	suite.Require().NoError(err)
+	suite.Require().Len(retries, 1)
+	suite.Require().Equal(int64(5), retries[0].Height)
+}
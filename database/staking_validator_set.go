@@ -0,0 +1,49 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/forbole/callisto/v4/types"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+)
+
+// SaveValidatorSetChanges stores the given validator set changes inside the database
+func (db *Db) SaveValidatorSetChanges(changes []types.ValidatorSetChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO validator_set_change (validator_address, type, height, timestamp) VALUES `
+	var args []interface{}
+
+	for i, change := range changes {
+		ci := i * 4
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d),", ci+1, ci+2, ci+3, ci+4)
+		args = append(args, change.ValidatorAddress, change.Type, change.Height, change.Timestamp)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += ` ON CONFLICT ON CONSTRAINT unique_validator_set_change DO NOTHING`
+
+	_, err := db.SQL.Exec(stmt, args...)
+	if err != nil {
+		return fmt.Errorf("error while storing validator set changes: %s", err)
+	}
+
+	return nil
+}
+
+// GetValidatorSetChanges returns all the validator set changes of the validator having the given
+// consensus address, ordered by ascending height
+func (db *Db) GetValidatorSetChanges(consAddr string) ([]dbtypes.ValidatorSetChangeRow, error) {
+	stmt := `SELECT * FROM validator_set_change WHERE validator_address = $1 ORDER BY height, type`
+
+	var rows []dbtypes.ValidatorSetChangeRow
+	err := db.Sqlx.Select(&rows, stmt, consAddr)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting validator set changes: %s", err)
+	}
+
+	return rows, nil
+}
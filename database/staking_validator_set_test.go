@@ -0,0 +1,46 @@
+package database_test
+
+import (
+	"time"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_ValidatorSetChanges() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	timestamp := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := suite.database.SaveValidatorSetChanges([]types.ValidatorSetChange{
+		types.NewValidatorSetChange(validator.GetConsAddr(), types.ValidatorSetChangeJoined, 10, timestamp),
+		types.NewValidatorSetChange(validator.GetConsAddr(), types.ValidatorSetChangeLeft, 20, timestamp),
+		types.NewValidatorSetChange(validator.GetConsAddr(), types.ValidatorSetChangeJailed, 20, timestamp),
+	})
+	suite.Require().NoError(err)
+
+	// Saving the same change twice should not duplicate it
+	err = suite.database.SaveValidatorSetChanges([]types.ValidatorSetChange{
+		types.NewValidatorSetChange(validator.GetConsAddr(), types.ValidatorSetChangeJoined, 10, timestamp),
+	})
+	suite.Require().NoError(err)
+
+	// Verify the lifecycle
+	rows, err := suite.database.GetValidatorSetChanges(validator.GetConsAddr())
+	suite.Require().NoError(err)
+	expected := []dbtypes.ValidatorSetChangeRow{
+		dbtypes.NewValidatorSetChangeRow(validator.GetConsAddr(), string(types.ValidatorSetChangeJoined), 10, timestamp),
+		dbtypes.NewValidatorSetChangeRow(validator.GetConsAddr(), string(types.ValidatorSetChangeJailed), 20, timestamp),
+		dbtypes.NewValidatorSetChangeRow(validator.GetConsAddr(), string(types.ValidatorSetChangeLeft), 20, timestamp),
+	}
+	suite.Require().Len(rows, len(expected))
+	for index, row := range rows {
+		suite.Require().Equal(expected[index].ValidatorAddress, row.ValidatorAddress)
+		suite.Require().Equal(expected[index].Type, row.Type)
+		suite.Require().Equal(expected[index].Height, row.Height)
+		suite.Require().True(expected[index].Timestamp.Equal(row.Timestamp))
+	}
+}
@@ -119,6 +119,30 @@ func (db *Db) GetValidatorOperatorAddress(consAddr string) (sdk.ValAddress, erro
 
 }
 
+// FindValidatorOperatorAddress returns the operator address of the validator having the given consensus address,
+// and false if the info of such validator have not been stored yet
+func (db *Db) FindValidatorOperatorAddress(consAddr string) (string, bool, error) {
+	var result []string
+	stmt := `SELECT operator_address FROM validator_info WHERE consensus_address = $1`
+	err := db.Sqlx.Select(&result, stmt, consAddr)
+	if err != nil {
+		return "", false, err
+	}
+
+	if len(result) == 0 {
+		return "", false, nil
+	}
+
+	return result[0], true, nil
+}
+
+// HasValidatorsInfo tells whether the info of at least one validator have been stored
+func (db *Db) HasValidatorsInfo() (bool, error) {
+	var exists bool
+	err := db.SQL.QueryRow(`SELECT EXISTS(SELECT 1 FROM validator_info)`).Scan(&exists)
+	return exists, err
+}
+
 // GetValidator returns the validator having the given address.
 // If no validator for such address can be found, an error is returned instead.
 func (db *Db) GetValidator(valAddress string) (types.Validator, error) {
@@ -447,7 +471,10 @@ func (db *Db) saveDoubleSignVote(vote types.DoubleSignVote) (int64, error) {
 	stmt := `
 INSERT INTO double_sign_vote 
     (type, height, round, block_id, validator_address, validator_index, signature) 
-VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT DO NOTHING RETURNING id`
+VALUES ($1, $2, $3, $4, $5, $6, $7) 
+ON CONFLICT (block_id, validator_address) DO UPDATE 
+    SET signature = excluded.signature
+RETURNING id`
 
 	var id int64
 	err := db.SQL.QueryRow(stmt,
@@ -486,11 +513,16 @@ VALUES `
 	}
 
 	stmt = stmt[:len(stmt)-1] // remove tailing ","
-	stmt += " ON CONFLICT DO NOTHING"
+	stmt += " ON CONFLICT ON CONSTRAINT unique_double_sign_evidence DO NOTHING"
 	_, err := db.SQL.Exec(stmt, doubleSignEvidence...)
 	if err != nil {
 		return fmt.Errorf("error while storing double sign evidences: %s", err)
 	}
 
-	return nil
+	heights := make([]int64, len(evidence))
+	for index, ev := range evidence {
+		heights[index] = ev.Height
+	}
+
+	return db.linkDoubleSignSlashes(heights)
 }
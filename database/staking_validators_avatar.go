@@ -0,0 +1,65 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// GetCachedAvatarURL returns the cached avatar URL of the given identity, and false if no avatar has been cached yet
+func (db *Db) GetCachedAvatarURL(identity string) (string, bool, error) {
+	var urls []string
+	err := db.Sqlx.Select(&urls, `SELECT avatar_url FROM validator_avatar_cache WHERE identity = $1`, identity)
+	if err != nil {
+		return "", false, err
+	}
+
+	if len(urls) == 0 {
+		return "", false, nil
+	}
+
+	return urls[0], true, nil
+}
+
+// GetAvatarIdentitiesToRefresh returns the identities set inside the validators description
+// whose avatar has never been cached or has been cached before the given time
+func (db *Db) GetAvatarIdentitiesToRefresh(cutoff time.Time) ([]string, error) {
+	stmt := `
+SELECT DISTINCT validator_description.identity 
+FROM validator_description
+LEFT JOIN validator_avatar_cache ON validator_avatar_cache.identity = validator_description.identity
+WHERE validator_description.identity IS NOT NULL 
+  AND validator_description.identity <> ''
+  AND (validator_avatar_cache.identity IS NULL OR validator_avatar_cache.updated_at < $1)
+ORDER BY validator_description.identity`
+
+	var identities []string
+	err := db.Sqlx.Select(&identities, stmt, cutoff)
+	return identities, err
+}
+
+// SaveValidatorAvatar stores the given avatar inside the cache,
+// and updates the avatar URL of all the validators having the same identity
+func (db *Db) SaveValidatorAvatar(avatar types.ValidatorAvatar) error {
+	stmt := `
+INSERT INTO validator_avatar_cache (identity, avatar_url, resolver, updated_at) 
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (identity) DO UPDATE 
+    SET avatar_url = excluded.avatar_url,
+        resolver = excluded.resolver,
+        updated_at = excluded.updated_at`
+
+	_, err := db.SQL.Exec(stmt, avatar.Identity, avatar.AvatarURL, avatar.Resolver, avatar.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error while storing validator avatar: %s", err)
+	}
+
+	stmt = `UPDATE validator_description SET avatar_url = $2 WHERE identity = $1`
+	_, err = db.SQL.Exec(stmt, avatar.Identity, avatar.AvatarURL)
+	if err != nil {
+		return fmt.Errorf("error while updating validators description avatar: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package database_test
+
+import (
+	"time"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_ValidatorAvatarCache() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	err := suite.database.SaveValidatorDescription(types.NewValidatorDescription(
+		validator.GetOperator(),
+		stakingtypes.NewDescription("moniker", "identity", "", "", ""),
+		"",
+		10,
+	))
+	suite.Require().NoError(err)
+
+	now := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	// Identities that have never been cached are refreshed
+	identities, err := suite.database.GetAvatarIdentitiesToRefresh(now)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"identity"}, identities)
+
+	_, found, err := suite.database.GetCachedAvatarURL("identity")
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	// Saving the avatar updates the description as well
+	err = suite.database.SaveValidatorAvatar(
+		types.NewValidatorAvatar("identity", "https://example.com/avatar.png", "keybase", now),
+	)
+	suite.Require().NoError(err)
+
+	url, found, err := suite.database.GetCachedAvatarURL("identity")
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal("https://example.com/avatar.png", url)
+
+	var avatarURL string
+	err = suite.database.SQL.QueryRow(`SELECT avatar_url FROM validator_description`).Scan(&avatarURL)
+	suite.Require().NoError(err)
+	suite.Require().Equal("https://example.com/avatar.png", avatarURL)
+
+	// Only expired identities are refreshed
+	identities, err = suite.database.GetAvatarIdentitiesToRefresh(now)
+	suite.Require().NoError(err)
+	suite.Require().Empty(identities)
+
+	identities, err = suite.database.GetAvatarIdentitiesToRefresh(now.Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"identity"}, identities)
+}
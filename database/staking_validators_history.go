@@ -0,0 +1,151 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// SaveValidatorsVotingPowersHistory stores the given voting powers inside the validators voting power history.
+// A voting power is stored only if it differs from the previous one of the same validator.
+// It assumes that the validators are already present inside the proper database table.
+func (db *Db) SaveValidatorsVotingPowersHistory(entries []types.ValidatorVotingPower) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO validator_voting_power_history (validator_address, voting_power, height) 
+SELECT v.validator_address, v.voting_power, v.height FROM (VALUES `
+	var params []interface{}
+
+	for i, entry := range entries {
+		pi := i * 3
+		stmt += fmt.Sprintf("($%d::TEXT,$%d::BIGINT,$%d::BIGINT),", pi+1, pi+2, pi+3)
+		params = append(params, entry.ConsensusAddress, entry.VotingPower, entry.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `) AS v (validator_address, voting_power, height)
+WHERE v.voting_power IS DISTINCT FROM (
+    SELECT h.voting_power FROM validator_voting_power_history h 
+    WHERE h.validator_address = v.validator_address AND h.height < v.height 
+    ORDER BY h.height DESC LIMIT 1
+)
+ON CONFLICT ON CONSTRAINT unique_validator_voting_power_history DO UPDATE 
+    SET voting_power = excluded.voting_power`
+
+	_, err := db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing validators voting power history: %s", err)
+	}
+
+	return nil
+}
+
+// SaveValidatorsStatusesHistory stores the given statuses inside the validators status history.
+// A status is stored only if it differs from the previous one of the same validator.
+// It assumes that the validators are already present inside the proper database table.
+func (db *Db) SaveValidatorsStatusesHistory(statuses []types.ValidatorStatus) error {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO validator_status_history (validator_address, status, jailed, height) 
+SELECT v.validator_address, v.status, v.jailed, v.height FROM (VALUES `
+	var params []interface{}
+
+	for i, status := range statuses {
+		si := i * 4
+		stmt += fmt.Sprintf("($%d::TEXT,$%d::INT,$%d::BOOLEAN,$%d::BIGINT),", si+1, si+2, si+3, si+4)
+		params = append(params, status.ConsensusAddress, status.Status, status.Jailed, status.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `) AS v (validator_address, status, jailed, height)
+WHERE (v.status, v.jailed) IS DISTINCT FROM (
+    SELECT h.status, h.jailed FROM validator_status_history h 
+    WHERE h.validator_address = v.validator_address AND h.height < v.height 
+    ORDER BY h.height DESC LIMIT 1
+)
+ON CONFLICT ON CONSTRAINT unique_validator_status_history DO UPDATE 
+    SET status = excluded.status,
+        jailed = excluded.jailed`
+
+	_, err := db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing validators status history: %s", err)
+	}
+
+	return nil
+}
+
+// SaveValidatorCommissionHistory stores the given commission inside the validators commission history.
+// The commission is stored only if it differs from the previous one of the same validator.
+// It assumes that the validator is already present inside the proper database table.
+func (db *Db) SaveValidatorCommissionHistory(data types.ValidatorCommission) error {
+	if data.MinSelfDelegation == nil || data.Commission == nil {
+		// Partial commissions cannot be stored inside the history
+		return nil
+	}
+
+	consAddr, err := db.GetValidatorConsensusAddress(data.ValAddress)
+	if err != nil {
+		return err
+	}
+
+	stmt := `
+INSERT INTO validator_commission_history (validator_address, commission, min_self_delegation, height) 
+SELECT v.validator_address, v.commission, v.min_self_delegation, v.height 
+FROM (VALUES ($1::TEXT, $2::DECIMAL, $3::BIGINT, $4::BIGINT)) AS v (validator_address, commission, min_self_delegation, height)
+WHERE (v.commission, v.min_self_delegation) IS DISTINCT FROM (
+    SELECT h.commission, h.min_self_delegation FROM validator_commission_history h 
+    WHERE h.validator_address = v.validator_address AND h.height < v.height 
+    ORDER BY h.height DESC LIMIT 1
+)
+ON CONFLICT ON CONSTRAINT unique_validator_commission_history DO UPDATE 
+    SET commission = excluded.commission,
+        min_self_delegation = excluded.min_self_delegation`
+
+	_, err = db.SQL.Exec(stmt,
+		consAddr.String(), data.Commission.String(), data.MinSelfDelegation.String(), data.Height)
+	if err != nil {
+		return fmt.Errorf("error while storing validator commission history: %s", err)
+	}
+
+	return nil
+}
+
+// PruneValidatorsHistory removes from the validators voting power, status, commission and delegation stats history
+// all the rows that are older than the given time.
+// For each validator, the latest row older than the given time is kept so that its value at such time is known
+func (db *Db) PruneValidatorsHistory(timestamp time.Time) error {
+	var height int64
+	err := db.SQL.QueryRow(`SELECT COALESCE(MAX(height), 0) FROM block WHERE timestamp <= $1`, timestamp).Scan(&height)
+	if err != nil {
+		return fmt.Errorf("error while getting validators history retention height: %s", err)
+	}
+
+	for _, table := range []string{
+		"validator_voting_power_history",
+		"validator_status_history",
+		"validator_commission_history",
+		"validator_delegation_stats_history",
+	} {
+		stmt := fmt.Sprintf(`
+DELETE FROM %[1]s old 
+WHERE old.height < $1 AND EXISTS (
+    SELECT 1 FROM %[1]s newer 
+    WHERE newer.validator_address = old.validator_address AND newer.height > old.height AND newer.height <= $1
+)`, table)
+
+		_, err := db.SQL.Exec(stmt, height)
+		if err != nil {
+			return fmt.Errorf("error while pruning %s: %s", table, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package database_test
+
+import (
+	"time"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+type validatorVotingPowerHistoryRow struct {
+	ValidatorAddress string `db:"validator_address"`
+	VotingPower      int64  `db:"voting_power"`
+	Height           int64  `db:"height"`
+}
+
+func (suite *DbTestSuite) TestSaveValidatorsVotingPowersHistory() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	for _, entry := range []types.ValidatorVotingPower{
+		types.NewValidatorVotingPower(validator.GetConsAddr(), 1000, 10),
+		types.NewValidatorVotingPower(validator.GetConsAddr(), 1000, 11),
+		types.NewValidatorVotingPower(validator.GetConsAddr(), 2000, 12),
+	} {
+		err := suite.database.SaveValidatorsVotingPowersHistory([]types.ValidatorVotingPower{entry})
+		suite.Require().NoError(err)
+	}
+
+	var rows []validatorVotingPowerHistoryRow
+	err := suite.database.Sqlx.Select(&rows, `
+SELECT validator_address, voting_power, height FROM validator_voting_power_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]validatorVotingPowerHistoryRow{
+		{validator.GetConsAddr(), 1000, 10},
+		{validator.GetConsAddr(), 2000, 12},
+	}, rows)
+}
+
+type validatorStatusHistoryRow struct {
+	ValidatorAddress string `db:"validator_address"`
+	Status           int    `db:"status"`
+	Jailed           bool   `db:"jailed"`
+	Height           int64  `db:"height"`
+}
+
+func (suite *DbTestSuite) TestSaveValidatorsStatusesHistory() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	for _, status := range []types.ValidatorStatus{
+		types.NewValidatorStatus(validator.GetConsAddr(), validator.GetConsPubKey(), 3, false, 10),
+		types.NewValidatorStatus(validator.GetConsAddr(), validator.GetConsPubKey(), 3, false, 11),
+		types.NewValidatorStatus(validator.GetConsAddr(), validator.GetConsPubKey(), 3, true, 12),
+	} {
+		err := suite.database.SaveValidatorsStatusesHistory([]types.ValidatorStatus{status})
+		suite.Require().NoError(err)
+	}
+
+	var rows []validatorStatusHistoryRow
+	err := suite.database.Sqlx.Select(&rows, `
+SELECT validator_address, status, jailed, height FROM validator_status_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]validatorStatusHistoryRow{
+		{validator.GetConsAddr(), 3, false, 10},
+		{validator.GetConsAddr(), 3, true, 12},
+	}, rows)
+}
+
+func (suite *DbTestSuite) TestSaveValidatorCommissionHistory() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	for _, commission := range []types.ValidatorCommission{
+		types.NewValidatorCommission(validator.GetOperator(), newDecPts(5, 2), newIntPtr(1), 10),
+		types.NewValidatorCommission(validator.GetOperator(), newDecPts(5, 2), newIntPtr(1), 11),
+		types.NewValidatorCommission(validator.GetOperator(), nil, newIntPtr(2), 12),
+		types.NewValidatorCommission(validator.GetOperator(), newDecPts(10, 2), newIntPtr(1), 13),
+	} {
+		err := suite.database.SaveValidatorCommissionHistory(commission)
+		suite.Require().NoError(err)
+	}
+
+	var heights []int64
+	err := suite.database.Sqlx.Select(&heights, `SELECT height FROM validator_commission_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{10, 13}, heights)
+}
+
+func (suite *DbTestSuite) TestPruneValidatorsHistory() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	now := time.Now().UTC()
+	for height, timestamp := range map[int64]time.Time{
+		10: now.Add(-72 * time.Hour),
+		11: now.Add(-48 * time.Hour),
+		12: now.Add(-1 * time.Hour),
+	} {
+		suite.getBlock(height)
+		_, err := suite.database.SQL.Exec(`UPDATE block SET timestamp = $1 WHERE height = $2`, timestamp, height)
+		suite.Require().NoError(err)
+	}
+
+	err := suite.database.SaveValidatorsVotingPowersHistory([]types.ValidatorVotingPower{
+		types.NewValidatorVotingPower(validator.GetConsAddr(), 1000, 10),
+	})
+	suite.Require().NoError(err)
+	err = suite.database.SaveValidatorsVotingPowersHistory([]types.ValidatorVotingPower{
+		types.NewValidatorVotingPower(validator.GetConsAddr(), 2000, 11),
+	})
+	suite.Require().NoError(err)
+	err = suite.database.SaveValidatorsVotingPowersHistory([]types.ValidatorVotingPower{
+		types.NewValidatorVotingPower(validator.GetConsAddr(), 3000, 12),
+	})
+	suite.Require().NoError(err)
+
+	// Prune everything older than one day, keeping the value at that time
+	err = suite.database.PruneValidatorsHistory(now.Add(-24 * time.Hour))
+	suite.Require().NoError(err)
+
+	var rows []validatorVotingPowerHistoryRow
+	err = suite.database.Sqlx.Select(&rows, `
+SELECT validator_address, voting_power, height FROM validator_voting_power_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]validatorVotingPowerHistoryRow{
+		{validator.GetConsAddr(), 2000, 11},
+		{validator.GetConsAddr(), 3000, 12},
+	}, rows)
+}
@@ -0,0 +1,84 @@
+package database
+
+import (
+	"fmt"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+// GetActiveValidatorsVotingPowers returns the voting power of each bonded and non jailed validator,
+// indexed by consensus address
+func (db *Db) GetActiveValidatorsVotingPowers() (map[string]int64, error) {
+	stmt := `
+SELECT validator_voting_power.* 
+FROM validator_voting_power 
+JOIN validator_status ON validator_status.validator_address = validator_voting_power.validator_address
+WHERE validator_status.status = 3 AND validator_status.jailed = false`
+
+	var rows []dbtypes.ValidatorVotingPowerRow
+	err := db.Sqlx.Select(&rows, stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	votingPowers := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		votingPowers[row.ValidatorAddress] = row.VotingPower
+	}
+
+	return votingPowers, nil
+}
+
+// SaveVotingPowerDistribution stores the given voting power distribution, along with the voting power share
+// of each validator, inside the database
+func (db *Db) SaveVotingPowerDistribution(distribution types.VotingPowerDistribution) error {
+	stmt := `
+INSERT INTO voting_power_distribution 
+    (validators_count, total_voting_power, nakamoto_coefficient, gini_coefficient, top_n, top_n_share, timestamp, height) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (height) DO UPDATE 
+    SET validators_count = excluded.validators_count,
+        total_voting_power = excluded.total_voting_power,
+        nakamoto_coefficient = excluded.nakamoto_coefficient,
+        gini_coefficient = excluded.gini_coefficient,
+        top_n = excluded.top_n,
+        top_n_share = excluded.top_n_share,
+        timestamp = excluded.timestamp`
+
+	_, err := db.SQL.Exec(stmt,
+		distribution.ValidatorsCount, distribution.TotalVotingPower, distribution.NakamotoCoefficient,
+		distribution.GiniCoefficient, distribution.TopN, distribution.TopNShare,
+		distribution.Timestamp, distribution.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing voting power distribution: %s", err)
+	}
+
+	if len(distribution.Shares) == 0 {
+		return nil
+	}
+
+	stmt = `INSERT INTO validator_voting_power_share (validator_address, voting_power, share, rank, height) VALUES `
+	var params []interface{}
+
+	for i, share := range distribution.Shares {
+		pi := i * 5
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", pi+1, pi+2, pi+3, pi+4, pi+5)
+		params = append(params, share.ValidatorAddress, share.VotingPower, share.Share, share.Rank, distribution.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT ON CONSTRAINT unique_validator_voting_power_share DO UPDATE 
+    SET voting_power = excluded.voting_power,
+        share = excluded.share,
+        rank = excluded.rank`
+
+	_, err = db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing validators voting power share: %s", err)
+	}
+
+	return nil
+}
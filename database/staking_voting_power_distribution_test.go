@@ -0,0 +1,88 @@
+package database_test
+
+import (
+	"time"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveVotingPowerDistribution() {
+	validator1 := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+	validator2 := suite.getValidator(
+		"cosmosvalcons1qq92t2l4jz5pt67tmts8ptl4p0jhr6utx5xa8y",
+		"cosmosvaloper1000ya26q2cmh399q4c5aaacd9lmmdqp90kw2jn",
+		"cosmosvalconspub1zcjduepqe93asg05nlnj30ej2pe3r8rkeryyuflhtfw3clqjphxn4j3u27msrr63nk",
+	)
+	validator3 := suite.getValidator(
+		"cosmosvalcons1rtst6se0nfgjy362v33jt5d05crgdyhfvvvvay",
+		"cosmosvaloper1jlr62guqwrwkdt4m3y00zh2rrsamhjf9num5xr",
+		"cosmosvalconspub1zcjduepq5e8w7t7k9pwfewgrwy8vn6cghk0x49chx64vt0054yl4wwsmjgrqfackxm",
+	)
+
+	err := suite.database.SaveValidatorsVotingPowers([]types.ValidatorVotingPower{
+		types.NewValidatorVotingPower(validator1.GetConsAddr(), 300, 10),
+		types.NewValidatorVotingPower(validator2.GetConsAddr(), 600, 10),
+		types.NewValidatorVotingPower(validator3.GetConsAddr(), 100, 10),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveValidatorsStatuses([]types.ValidatorStatus{
+		types.NewValidatorStatus(validator1.GetConsAddr(), validator1.GetConsPubKey(), 3, false, 10),
+		types.NewValidatorStatus(validator2.GetConsAddr(), validator2.GetConsPubKey(), 3, false, 10),
+		types.NewValidatorStatus(validator3.GetConsAddr(), validator3.GetConsPubKey(), 3, true, 10),
+	})
+	suite.Require().NoError(err)
+
+	// Jailed validators are not part of the active set
+	votingPowers, err := suite.database.GetActiveValidatorsVotingPowers()
+	suite.Require().NoError(err)
+	suite.Require().Equal(map[string]int64{
+		validator1.GetConsAddr(): 300,
+		validator2.GetConsAddr(): 600,
+	}, votingPowers)
+
+	timestamp := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	distribution := types.NewVotingPowerDistribution(votingPowers, 1, timestamp, 10)
+	suite.Require().Equal(2, distribution.ValidatorsCount)
+	suite.Require().Equal(int64(900), distribution.TotalVotingPower)
+	suite.Require().Equal(1, distribution.NakamotoCoefficient)
+	suite.Require().InDelta(1.0/6, distribution.GiniCoefficient, 1e-9)
+	suite.Require().InDelta(2.0/3, distribution.TopNShare, 1e-9)
+
+	err = suite.database.SaveVotingPowerDistribution(distribution)
+	suite.Require().NoError(err)
+
+	// Saving the same height twice should not fail
+	err = suite.database.SaveVotingPowerDistribution(distribution)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.VotingPowerDistributionRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM voting_power_distribution`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(2, rows[0].ValidatorsCount)
+	suite.Require().Equal(int64(900), rows[0].TotalVotingPower)
+	suite.Require().Equal(1, rows[0].NakamotoCoefficient)
+	suite.Require().InDelta(1.0/6, rows[0].GiniCoefficient, 1e-9)
+	suite.Require().Equal(1, rows[0].TopN)
+	suite.Require().InDelta(2.0/3, rows[0].TopNShare, 1e-9)
+	suite.Require().True(timestamp.Equal(rows[0].Timestamp))
+	suite.Require().Equal(int64(10), rows[0].Height)
+
+	var shares []dbtypes.ValidatorVotingPowerShareRow
+	err = suite.database.Sqlx.Select(&shares, `SELECT * FROM validator_voting_power_share ORDER BY rank`)
+	suite.Require().NoError(err)
+	suite.Require().Len(shares, 2)
+	suite.Require().Equal(validator2.GetConsAddr(), shares[0].ValidatorAddress)
+	suite.Require().Equal(int64(600), shares[0].VotingPower)
+	suite.Require().InDelta(2.0/3, shares[0].Share, 1e-9)
+	suite.Require().Equal(1, shares[0].Rank)
+	suite.Require().Equal(validator1.GetConsAddr(), shares[1].ValidatorAddress)
+	suite.Require().InDelta(1.0/3, shares[1].Share, 1e-9)
+	suite.Require().Equal(2, shares[1].Rank)
+}
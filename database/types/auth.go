@@ -1,8 +1,24 @@
 package types
 
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
 // AccountRow represents a single row inside the account table
 type AccountRow struct {
-	Address string `db:"address"`
+	Address           string         `db:"address"`
+	Type              sql.NullString `db:"type"`
+	PubKeyType        sql.NullString `db:"pub_key_type"`
+	PubKey            sql.NullString `db:"pub_key"`
+	AccountNumber     sql.NullInt64  `db:"account_number"`
+	Sequence          sql.NullInt64  `db:"sequence"`
+	ModuleName        sql.NullString `db:"module_name"`
+	ModulePermissions pq.StringArray `db:"module_permissions"`
+	FirstSeenHeight   sql.NullInt64  `db:"first_seen_height"`
+	LastSeenHeight    sql.NullInt64  `db:"last_seen_height"`
 }
 
 // NewAccountRow allows to easily build a new AccountRow
@@ -14,5 +30,40 @@ func NewAccountRow(address string) AccountRow {
 
 // Equal tells whether a and b contain the same data
 func (a AccountRow) Equal(b AccountRow) bool {
-	return a.Address == b.Address
+	if len(a.ModulePermissions) != len(b.ModulePermissions) {
+		return false
+	}
+	for index, permission := range a.ModulePermissions {
+		if permission != b.ModulePermissions[index] {
+			return false
+		}
+	}
+
+	return a.Address == b.Address &&
+		a.Type == b.Type &&
+		a.PubKeyType == b.PubKeyType &&
+		a.PubKey == b.PubKey &&
+		a.AccountNumber == b.AccountNumber &&
+		a.Sequence == b.Sequence &&
+		a.ModuleName == b.ModuleName &&
+		a.FirstSeenHeight == b.FirstSeenHeight &&
+		a.LastSeenHeight == b.LastSeenHeight
+}
+
+// VestingAccountRow represents a single row inside the vesting_account table
+type VestingAccountRow struct {
+	ID              int64        `db:"id"`
+	Type            string       `db:"type"`
+	Address         string       `db:"address"`
+	OriginalVesting *DbCoins     `db:"original_vesting"`
+	EndTime         time.Time    `db:"end_time"`
+	StartTime       sql.NullTime `db:"start_time"`
+}
+
+// VestingPeriodRow represents a single row inside the vesting_period table
+type VestingPeriodRow struct {
+	VestingAccountID int64    `db:"vesting_account_id"`
+	PeriodOrder      int64    `db:"period_order"`
+	Length           int64    `db:"length"`
+	Amount           *DbCoins `db:"amount"`
 }
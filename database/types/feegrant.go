@@ -1,10 +1,35 @@
 package types
 
+import (
+	"database/sql"
+	"time"
+)
+
 // FeeAllowanceRow represents a single row inside the fee_grant_allowance table
 type FeeAllowanceRow struct {
-	ID        uint64 `db:"id"`
-	Grantee   string `db:"grantee_address"`
-	Granter   string `db:"granter_address"`
-	Allowance string `db:"allowance"`
-	Height    int64  `db:"height"`
+	ID         uint64     `db:"id"`
+	Grantee    string     `db:"grantee_address"`
+	Granter    string     `db:"granter_address"`
+	Allowance  string     `db:"allowance"`
+	Expiration *time.Time `db:"expiration"`
+	Height     int64      `db:"height"`
+}
+
+// FeeAllowanceHistoryRow represents a single row inside the fee_grant_allowance_history table
+type FeeAllowanceHistoryRow struct {
+	Grantee   string         `db:"grantee_address"`
+	Granter   string         `db:"granter_address"`
+	Allowance string         `db:"allowance"`
+	Status    string         `db:"status"`
+	TxHash    sql.NullString `db:"transaction_hash"`
+	Height    int64          `db:"height"`
+}
+
+// FeeGrantUsageRow represents a single row inside the fee_grant_usage table
+type FeeGrantUsageRow struct {
+	TxHash  string   `db:"transaction_hash"`
+	Grantee string   `db:"grantee_address"`
+	Granter string   `db:"granter_address"`
+	Fee     *DbCoins `db:"fee"`
+	Height  int64    `db:"height"`
 }
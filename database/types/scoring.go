@@ -0,0 +1,23 @@
+package types
+
+import "time"
+
+// ValidatorScoreRow represents a single row of the validator_score table
+type ValidatorScoreRow struct {
+	ValidatorAddress    string    `db:"validator_address"`
+	SelfDelegationRatio float64   `db:"self_delegation_ratio"`
+	Uptime              float64   `db:"uptime"`
+	EverSlashed         bool      `db:"ever_slashed"`
+	GovParticipation    float64   `db:"gov_participation"`
+	DelegatorsCount     int64     `db:"delegators_count"`
+	Score               float64   `db:"score"`
+	Timestamp           time.Time `db:"timestamp"`
+	Height              int64     `db:"height"`
+}
+
+// ValidatorRankingRow represents a single row of the validators ranking
+type ValidatorRankingRow struct {
+	ValidatorScoreRow
+	OperatorAddress string `db:"operator_address"`
+	Rank            int64  `db:"rank"`
+}
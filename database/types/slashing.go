@@ -1,6 +1,9 @@
 package types
 
-import "time"
+import (
+	"database/sql"
+	"time"
+)
 
 // ValidatorSigningInfoRow represents a single row of the validator_signing_info table
 type ValidatorSigningInfoRow struct {
@@ -62,3 +65,37 @@ func NewSlashingParamsRow(params string, height int64) SlashingParamsRow {
 		Height:   height,
 	}
 }
+
+// ValidatorUptimeRow represents a single row of the validator_uptime table
+type ValidatorUptimeRow struct {
+	ValidatorAddress string  `db:"validator_address"`
+	Period           string  `db:"period"`
+	StartHeight      int64   `db:"start_height"`
+	SignedBlocks     int64   `db:"signed_blocks"`
+	ExpectedBlocks   int64   `db:"expected_blocks"`
+	Uptime           float64 `db:"uptime"`
+	Height           int64   `db:"height"`
+}
+
+// ValidatorSlashRow represents a single row of the validator_slash table
+type ValidatorSlashRow struct {
+	ValidatorAddress string        `db:"validator_address"`
+	Power            int64         `db:"power"`
+	Reason           string        `db:"reason"`
+	BurnedTokens     string        `db:"burned_tokens"`
+	Jailed           bool          `db:"jailed"`
+	DoubleSignVoteID sql.NullInt64 `db:"double_sign_vote_id"`
+	Height           int64         `db:"height"`
+}
+
+// ValidatorJailEventRow represents a single row of the validator_jail_event table
+type ValidatorJailEventRow struct {
+	ValidatorAddress string        `db:"validator_address"`
+	Reason           string        `db:"reason"`
+	JailedHeight     int64         `db:"jailed_height"`
+	JailedAt         time.Time     `db:"jailed_at"`
+	JailedUntil      sql.NullTime  `db:"jailed_until"`
+	Tombstoned       bool          `db:"tombstoned"`
+	UnjailedHeight   sql.NullInt64 `db:"unjailed_height"`
+	UnjailedAt       sql.NullTime  `db:"unjailed_at"`
+}
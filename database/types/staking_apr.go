@@ -0,0 +1,21 @@
+package types
+
+import "time"
+
+// StakingAPRRow represents a single row of the staking_apr table
+type StakingAPRRow struct {
+	APR          string    `db:"apr"`
+	Inflation    string    `db:"inflation"`
+	BondedRatio  string    `db:"bonded_ratio"`
+	CommunityTax string    `db:"community_tax"`
+	Timestamp    time.Time `db:"timestamp"`
+	Height       int64     `db:"height"`
+}
+
+// ValidatorAPRRow represents a single row of the validator_apr table
+type ValidatorAPRRow struct {
+	ValidatorAddress string `db:"validator_address"`
+	Commission       string `db:"commission"`
+	APR              string `db:"apr"`
+	Height           int64  `db:"height"`
+}
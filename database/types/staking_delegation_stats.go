@@ -0,0 +1,14 @@
+package types
+
+import "database/sql"
+
+// ValidatorDelegationStatsRow represents a single row of the validator_delegation_stats
+// and validator_delegation_stats_history tables
+type ValidatorDelegationStatsRow struct {
+	ValidatorAddress    string         `db:"validator_address"`
+	SelfDelegation      string         `db:"self_delegation"`
+	MinSelfDelegation   int64          `db:"min_self_delegation"`
+	SelfDelegationRatio sql.NullString `db:"self_delegation_ratio"`
+	DelegatorsCount     int            `db:"delegators_count"`
+	Height              int64          `db:"height"`
+}
@@ -0,0 +1,35 @@
+package types
+
+import "time"
+
+// DelegationRow represents a single row of the delegation table
+type DelegationRow struct {
+	DelegatorAddress string  `db:"delegator_address"`
+	ValidatorAddress string  `db:"validator_address"`
+	Shares           string  `db:"shares"`
+	Amount           *DbCoin `db:"amount"`
+	Height           int64   `db:"height"`
+}
+
+// UnbondingDelegationRow represents a single row of the unbonding_delegation table
+type UnbondingDelegationRow struct {
+	DelegatorAddress string    `db:"delegator_address"`
+	ValidatorAddress string    `db:"validator_address"`
+	CreationHeight   int64     `db:"creation_height"`
+	CompletionTime   time.Time `db:"completion_time"`
+	InitialBalance   string    `db:"initial_balance"`
+	Balance          string    `db:"balance"`
+	Height           int64     `db:"height"`
+}
+
+// RedelegationRow represents a single row of the redelegation table
+type RedelegationRow struct {
+	DelegatorAddress    string    `db:"delegator_address"`
+	SrcValidatorAddress string    `db:"src_validator_address"`
+	DstValidatorAddress string    `db:"dst_validator_address"`
+	CreationHeight      int64     `db:"creation_height"`
+	CompletionTime      time.Time `db:"completion_time"`
+	InitialBalance      string    `db:"initial_balance"`
+	Balance             string    `db:"balance"`
+	Height              int64     `db:"height"`
+}
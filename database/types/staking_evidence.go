@@ -0,0 +1,12 @@
+package types
+
+import "time"
+
+// EvidenceRetryRow represents a single row of the evidence_retry_queue table
+type EvidenceRetryRow struct {
+	Height    int64     `db:"height"`
+	Evidences []byte    `db:"evidences"`
+	Attempts  int       `db:"attempts"`
+	LastError string    `db:"last_error"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
@@ -0,0 +1,21 @@
+package types
+
+import "time"
+
+// ValidatorSetChangeRow represents a single row of the validator_set_change table
+type ValidatorSetChangeRow struct {
+	ValidatorAddress string    `db:"validator_address"`
+	Type             string    `db:"type"`
+	Height           int64     `db:"height"`
+	Timestamp        time.Time `db:"timestamp"`
+}
+
+// NewValidatorSetChangeRow allows to build a new ValidatorSetChangeRow instance
+func NewValidatorSetChangeRow(validatorAddress string, changeType string, height int64, timestamp time.Time) ValidatorSetChangeRow {
+	return ValidatorSetChangeRow{
+		ValidatorAddress: validatorAddress,
+		Type:             changeType,
+		Height:           height,
+		Timestamp:        timestamp,
+	}
+}
@@ -0,0 +1,24 @@
+package types
+
+import "time"
+
+// VotingPowerDistributionRow represents a single row of the voting_power_distribution table
+type VotingPowerDistributionRow struct {
+	ValidatorsCount     int       `db:"validators_count"`
+	TotalVotingPower    int64     `db:"total_voting_power"`
+	NakamotoCoefficient int       `db:"nakamoto_coefficient"`
+	GiniCoefficient     float64   `db:"gini_coefficient"`
+	TopN                int       `db:"top_n"`
+	TopNShare           float64   `db:"top_n_share"`
+	Timestamp           time.Time `db:"timestamp"`
+	Height              int64     `db:"height"`
+}
+
+// ValidatorVotingPowerShareRow represents a single row of the validator_voting_power_share table
+type ValidatorVotingPowerShareRow struct {
+	ValidatorAddress string  `db:"validator_address"`
+	VotingPower      int64   `db:"voting_power"`
+	Share            float64 `db:"share"`
+	Rank             int     `db:"rank"`
+	Height           int64   `db:"height"`
+}
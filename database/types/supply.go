@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 // SupplyRow represents a single row inside the "supply" table
 type SupplyRow struct {
 	OneRowID bool     `db:"one_row_id"`
@@ -21,3 +23,56 @@ func (v SupplyRow) Equals(w SupplyRow) bool {
 	return v.Coins.Equal(w.Coins) &&
 		v.Height == w.Height
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SupplyHistoryRow represents a single row inside the "supply_history" table
+type SupplyHistoryRow struct {
+	Denom     string    `db:"denom"`
+	Amount    string    `db:"amount"`
+	Timestamp time.Time `db:"timestamp"`
+	Height    int64     `db:"height"`
+}
+
+// NewSupplyHistoryRow allows to easily create a new SupplyHistoryRow
+func NewSupplyHistoryRow(denom string, amount string, timestamp time.Time, height int64) SupplyHistoryRow {
+	return SupplyHistoryRow{
+		Denom:     denom,
+		Amount:    amount,
+		Timestamp: timestamp,
+		Height:    height,
+	}
+}
+
+// Equals return true if v and w represent the same row
+func (v SupplyHistoryRow) Equals(w SupplyHistoryRow) bool {
+	return v.Denom == w.Denom &&
+		v.Amount == w.Amount &&
+		v.Timestamp.Equal(w.Timestamp) &&
+		v.Height == w.Height
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// AccountBalanceRow represents a single row inside the "account_balance" table
+type AccountBalanceRow struct {
+	Address string   `db:"address"`
+	Coins   *DbCoins `db:"coins"`
+	Height  int64    `db:"height"`
+}
+
+// NewAccountBalanceRow allows to easily create a new AccountBalanceRow
+func NewAccountBalanceRow(address string, coins DbCoins, height int64) AccountBalanceRow {
+	return AccountBalanceRow{
+		Address: address,
+		Coins:   &coins,
+		Height:  height,
+	}
+}
+
+// Equals return true if v and w represent the same row
+func (v AccountBalanceRow) Equals(w AccountBalanceRow) bool {
+	return v.Address == w.Address &&
+		v.Coins.Equal(w.Coins) &&
+		v.Height == w.Height
+}
@@ -21,3 +21,19 @@ func SplitAccounts(accounts []types.Account, paramsNumber int) [][]types.Account
 
 	return slices
 }
+
+func SplitAccountsDetails(accounts []types.AccountDetails, paramsNumber int) [][]types.AccountDetails {
+	maxBalancesPerSlice := maxPostgreSQLParams / paramsNumber
+	slices := make([][]types.AccountDetails, len(accounts)/maxBalancesPerSlice+1)
+
+	sliceIndex := 0
+	for index, account := range accounts {
+		slices[sliceIndex] = append(slices[sliceIndex], account)
+
+		if index > 0 && index%(maxBalancesPerSlice-1) == 0 {
+			sliceIndex++
+		}
+	}
+
+	return slices
+}
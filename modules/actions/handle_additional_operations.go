@@ -16,19 +16,26 @@ var (
 
 func (m *Module) RunAdditionalOperations() error {
 	// Build the worker
-	context := actionstypes.NewContext(m.node, m.sources)
+	context := actionstypes.NewContext(m.node, m.sources, m.db)
 	worker := actionstypes.NewActionsWorker(context)
 
 	// Register the endpoints
 
+	// -- Auth --
+	worker.RegisterHandler("/vesting_account", handlers.VestingAccountHandler)
+
 	// -- Bank --
 	worker.RegisterHandler("/account_balance", handlers.AccountBalanceHandler)
+	worker.RegisterHandler("/circulating_supply", handlers.CirculatingSupplyHandler)
 
 	// -- Distribution --
 	worker.RegisterHandler("/delegation_reward", handlers.DelegationRewardHandler)
 	worker.RegisterHandler("/delegator_withdraw_address", handlers.DelegatorWithdrawAddressHandler)
 	worker.RegisterHandler("/validator_commission_amount", handlers.ValidatorCommissionAmountHandler)
 
+	// -- Slashing --
+	worker.RegisterHandler("/validator_missed_blocks", handlers.ValidatorMissedBlocksHandler)
+
 	// -- Staking Delegator --
 	worker.RegisterHandler("/delegation", handlers.DelegationHandler)
 	worker.RegisterHandler("/delegation_total", handlers.TotalDelegationAmountHandler)
@@ -38,8 +45,13 @@ func (m *Module) RunAdditionalOperations() error {
 
 	// -- Staking Validator --
 	worker.RegisterHandler("/validator_delegations", handlers.ValidatorDelegation)
+	worker.RegisterHandler("/validator_lifecycle", handlers.ValidatorLifecycleHandler)
 	worker.RegisterHandler("/validator_redelegations_from", handlers.ValidatorRedelegationsFromHandler)
 	worker.RegisterHandler("/validator_unbonding_delegations", handlers.ValidatorUnbondingDelegationsHandler)
+	worker.RegisterHandler("/staking_rewards_estimate", handlers.StakingRewardsEstimateHandler)
+
+	// -- Scoring --
+	worker.RegisterHandler("/validator_ranking", handlers.ValidatorRankingHandler)
 
 	// Listen for and trap any OS signal to gracefully shutdown and exit
 	m.trapSignal()
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/actions/types"
+)
+
+func CirculatingSupplyHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Int64("height", payload.Input.Height).
+		Msg("executing circulating supply action")
+
+	// Return the latest value when no height is given
+	if payload.Input.Height == 0 {
+		row, err := ctx.Db.GetCirculatingSupply()
+		if err != nil {
+			return nil, fmt.Errorf("error while getting circulating supply: %s", err)
+		}
+
+		if row == nil {
+			return nil, fmt.Errorf("circulating supply has not been computed yet")
+		}
+
+		return types.CirculatingSupply{
+			Coins:  types.ConvertCoins(row.Coins.ToCoins()),
+			Height: row.Height,
+		}, nil
+	}
+
+	rows, err := ctx.Db.GetCirculatingSupplyHistory(payload.Input.Height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting circulating supply history: %s", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no circulating supply found at height %d", payload.Input.Height)
+	}
+
+	coins := make(sdk.Coins, len(rows))
+	for i, row := range rows {
+		amount, ok := sdk.NewIntFromString(row.Amount)
+		if !ok {
+			return nil, fmt.Errorf("invalid circulating supply amount: %s", row.Amount)
+		}
+		coins[i] = sdk.NewCoin(row.Denom, amount)
+	}
+
+	return types.CirculatingSupply{
+		Coins:  types.ConvertCoins(coins),
+		Height: rows[0].Height,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/actions/types"
+)
+
+func StakingRewardsEstimateHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Str("address", payload.GetAddress()).
+		Str("amount", payload.Input.Amount).
+		Msg("executing staking rewards estimate action")
+
+	amount, ok := sdk.NewIntFromString(payload.Input.Amount)
+	if !ok || amount.IsNegative() {
+		return nil, fmt.Errorf("invalid amount: %s", payload.Input.Amount)
+	}
+
+	consAddr, err := getValidatorConsensusAddress(ctx, payload.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	stakingAPR, err := ctx.Db.GetLatestStakingAPR()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting staking apr: %s", err)
+	}
+
+	validatorAPR, err := ctx.Db.GetValidatorAPR(consAddr)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting validator apr: %s", err)
+	}
+
+	if stakingAPR == nil || validatorAPR == nil {
+		return nil, fmt.Errorf("staking apr has not been computed yet")
+	}
+
+	apr, err := sdk.NewDecFromStr(stakingAPR.APR)
+	if err != nil {
+		return nil, fmt.Errorf("invalid staking apr: %s", err)
+	}
+
+	commission, err := sdk.NewDecFromStr(validatorAPR.Commission)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator commission: %s", err)
+	}
+
+	netAPR, err := sdk.NewDecFromStr(validatorAPR.APR)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator apr: %s", err)
+	}
+
+	return types.StakingRewardsEstimate{
+		ValidatorAddress: consAddr,
+		Amount:           amount,
+		StakingAPR:       apr,
+		Commission:       commission,
+		ValidatorAPR:     netAPR,
+		AnnualReward:     sdk.NewDecFromInt(amount).Mul(netAPR).TruncateInt(),
+		Height:           validatorAPR.Height,
+	}, nil
+}
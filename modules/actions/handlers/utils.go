@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/callisto/v4/modules/actions/types"
+)
+
+// getValidatorConsensusAddress returns the consensus address of the validator having the given address,
+// which can be either its operator or its consensus address
+func getValidatorConsensusAddress(ctx *types.Context, address string) (string, error) {
+	if _, err := sdk.ValAddressFromBech32(address); err != nil {
+		return address, nil
+	}
+
+	consAddr, err := ctx.Db.GetValidatorConsensusAddress(address)
+	if err != nil {
+		return "", fmt.Errorf("error while getting validator consensus address: %s", err)
+	}
+
+	return consAddr.String(), nil
+}
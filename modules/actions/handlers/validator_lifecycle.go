@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/actions/types"
+)
+
+func ValidatorLifecycleHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Str("address", payload.GetAddress()).
+		Msg("executing validator lifecycle action")
+
+	consAddr, err := getValidatorConsensusAddress(ctx, payload.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := ctx.Db.GetValidatorSetChanges(consAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]types.ValidatorSetChange, len(rows))
+	for i, row := range rows {
+		changes[i] = types.ValidatorSetChange{
+			Type:      row.Type,
+			Height:    row.Height,
+			Timestamp: row.Timestamp,
+		}
+	}
+
+	return types.ValidatorLifecycle{
+		ValidatorAddress: consAddr,
+		Changes:          changes,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/actions/types"
+)
+
+func ValidatorMissedBlocksHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Str("address", payload.GetAddress()).
+		Int64("height", payload.Input.Height).
+		Msg("executing validator missed blocks action")
+
+	consAddr, err := getValidatorConsensusAddress(ctx, payload.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the latest signed block when no height is given
+	endHeight := payload.Input.Height
+	if endHeight == 0 {
+		block, err := ctx.Db.GetLastSignedBlock()
+		if err != nil {
+			return nil, err
+		}
+		endHeight = block.Height
+	}
+
+	params, err := ctx.Sources.SlashingSource.GetParams(endHeight)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting slashing params: %s", err)
+	}
+
+	startHeight := endHeight - params.SignedBlocksWindow + 1
+	if startHeight < 1 {
+		startHeight = 1
+	}
+
+	heights, err := ctx.Db.GetValidatorMissedBlocks(consAddr, startHeight, endHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	if heights == nil {
+		heights = []int64{}
+	}
+
+	return types.ValidatorMissedBlocks{
+		ValidatorAddress: consAddr,
+		StartHeight:      startHeight,
+		EndHeight:        endHeight,
+		MissedBlocks:     heights,
+	}, nil
+}
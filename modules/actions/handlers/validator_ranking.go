@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/actions/types"
+)
+
+func ValidatorRankingHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Uint64("offset", payload.Input.Offset).
+		Uint64("limit", payload.Input.Limit).
+		Msg("executing validator ranking action")
+
+	limit := payload.Input.Limit
+	if limit == 0 {
+		limit = 100
+	}
+
+	rows, err := ctx.Db.GetValidatorsRanking(payload.Input.Offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("validators scores have not been computed yet")
+	}
+
+	validators := make([]types.ValidatorScore, len(rows))
+	for i, row := range rows {
+		validators[i] = types.ValidatorScore{
+			Rank:                row.Rank,
+			OperatorAddress:     row.OperatorAddress,
+			ConsensusAddress:    row.ValidatorAddress,
+			Score:               row.Score,
+			SelfDelegationRatio: row.SelfDelegationRatio,
+			Uptime:              row.Uptime,
+			EverSlashed:         row.EverSlashed,
+			GovParticipation:    row.GovParticipation,
+			DelegatorsCount:     row.DelegatorsCount,
+		}
+	}
+
+	return types.ValidatorRanking{
+		Validators: validators,
+		Height:     rows[0].Height,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/actions/types"
+)
+
+func VestingAccountHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Str("address", payload.GetAddress()).
+		Int64("height", payload.Input.Height).
+		Str("time", payload.Input.Time).
+		Msg("executing vesting account action")
+
+	height, err := ctx.GetHeight(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := ctx.GetTime(payload, height)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := ctx.Sources.AuthSource.GetAccounts([]string{payload.GetAddress()}, height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting account: %s", err)
+	}
+
+	if len(accounts) == 0 {
+		return nil, fmt.Errorf("account %s not found at height %d", payload.GetAddress(), height)
+	}
+
+	vestingAccount, ok := accounts[0].(exported.VestingAccount)
+	if !ok {
+		return nil, fmt.Errorf("account %s is not a vesting account", payload.GetAddress())
+	}
+
+	balance, err := ctx.Sources.BankSource.GetAccountBalance(payload.GetAddress(), height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting account balance: %s", err)
+	}
+
+	// Compute the spendable coins the same way the bank keeper does
+	locked := vestingAccount.LockedCoins(t)
+	spendable, hasNeg := sdk.NewCoins(balance...).SafeSub(locked...)
+	if hasNeg {
+		spendable = sdk.NewCoins()
+	}
+
+	return types.VestingAccountAmounts{
+		OriginalVesting: types.ConvertCoins(vestingAccount.GetOriginalVesting()),
+		Vested:          types.ConvertCoins(vestingAccount.GetVestedCoins(t)),
+		Vesting:         types.ConvertCoins(vestingAccount.GetVestingCoins(t)),
+		Locked:          types.ConvertCoins(locked),
+		Spendable:       types.ConvertCoins(spendable),
+		Height:          height,
+		Time:            t,
+	}, nil
+}
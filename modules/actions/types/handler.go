@@ -2,9 +2,11 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/forbole/juno/v5/node"
 
+	"github.com/forbole/callisto/v4/database"
 	modulestypes "github.com/forbole/callisto/v4/modules/types"
 )
 
@@ -12,13 +14,15 @@ import (
 type Context struct {
 	node    node.Node
 	Sources *modulestypes.Sources
+	Db      *database.Db
 }
 
 // NewContext returns a new Context instance
-func NewContext(node node.Node, sources *modulestypes.Sources) *Context {
+func NewContext(node node.Node, sources *modulestypes.Sources, db *database.Db) *Context {
 	return &Context{
 		node:    node,
 		Sources: sources,
+		Db:      db,
 	}
 }
 
@@ -35,6 +39,25 @@ func (c *Context) GetHeight(payload *Payload) (int64, error) {
 	return payload.Input.Height, nil
 }
 
+// GetTime returns the time given inside the payload, or the time of the block at the given height
+// when the input time is empty from graphql request
+func (c *Context) GetTime(payload *Payload, height int64) (time.Time, error) {
+	if payload != nil && payload.Input.Time != "" {
+		t, err := time.Parse(time.RFC3339, payload.Input.Time)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("error while parsing time: %s", err)
+		}
+		return t, nil
+	}
+
+	block, err := c.node.Block(height)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error while getting block at height %d: %s", height, err)
+	}
+
+	return block.Block.Time, nil
+}
+
 // ActionHandler represents a Hasura action request handler.
 // It returns an interface to be returned to the called, or an error if something is wrong
 type ActionHandler = func(context *Context, payload *Payload) (interface{}, error)
@@ -25,7 +25,9 @@ func (p *Payload) GetPagination() *query.PageRequest {
 type PayloadArgs struct {
 	Address    string `json:"address"`
 	Height     int64  `json:"height"`
+	Time       string `json:"time"`
 	Offset     uint64 `json:"offset"`
 	Limit      uint64 `json:"limit"`
 	CountTotal bool   `json:"count_total"`
+	Amount     string `json:"amount"`
 }
@@ -42,6 +42,25 @@ type Balance struct {
 	Coins []Coin `json:"coins"`
 }
 
+// ========================= Vesting Account Response =========================
+
+type VestingAccountAmounts struct {
+	OriginalVesting []Coin    `json:"original_vesting"`
+	Vested          []Coin    `json:"vested"`
+	Vesting         []Coin    `json:"vesting"`
+	Locked          []Coin    `json:"locked"`
+	Spendable       []Coin    `json:"spendable"`
+	Height          int64     `json:"height"`
+	Time            time.Time `json:"time"`
+}
+
+// ========================= Circulating Supply Response =========================
+
+type CirculatingSupply struct {
+	Coins  []Coin `json:"coins"`
+	Height int64  `json:"height"`
+}
+
 // ========================= Delegation Response =========================
 
 type DelegationResponse struct {
@@ -99,3 +118,56 @@ type RedelegationEntry struct {
 	CompletionTime time.Time   `json:"completion_time"`
 	Balance        sdkmath.Int `json:"balance"`
 }
+
+// ========================= Validator Missed Blocks Response =========================
+
+type ValidatorMissedBlocks struct {
+	ValidatorAddress string  `json:"validator_address"`
+	StartHeight      int64   `json:"start_height"`
+	EndHeight        int64   `json:"end_height"`
+	MissedBlocks     []int64 `json:"missed_blocks"`
+}
+
+// ========================= Validator Ranking Response =========================
+
+type ValidatorRanking struct {
+	Validators []ValidatorScore `json:"validators"`
+	Height     int64            `json:"height"`
+}
+
+type ValidatorScore struct {
+	Rank                int64   `json:"rank"`
+	OperatorAddress     string  `json:"operator_address"`
+	ConsensusAddress    string  `json:"consensus_address"`
+	Score               float64 `json:"score"`
+	SelfDelegationRatio float64 `json:"self_delegation_ratio"`
+	Uptime              float64 `json:"uptime"`
+	EverSlashed         bool    `json:"ever_slashed"`
+	GovParticipation    float64 `json:"gov_participation"`
+	DelegatorsCount     int64   `json:"delegators_count"`
+}
+
+// ========================= Validator Lifecycle Response =========================
+
+type ValidatorLifecycle struct {
+	ValidatorAddress string               `json:"validator_address"`
+	Changes          []ValidatorSetChange `json:"changes"`
+}
+
+type ValidatorSetChange struct {
+	Type      string    `json:"type"`
+	Height    int64     `json:"height"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ========================= Staking Rewards Estimate Response =========================
+
+type StakingRewardsEstimate struct {
+	ValidatorAddress string      `json:"validator_address"`
+	Amount           sdkmath.Int `json:"amount"`
+	StakingAPR       sdk.Dec     `json:"staking_apr"`
+	Commission       sdk.Dec     `json:"commission"`
+	ValidatorAPR     sdk.Dec     `json:"validator_apr"`
+	AnnualReward     sdkmath.Int `json:"annual_reward"`
+	Height           int64       `json:"height"`
+}
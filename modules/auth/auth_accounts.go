@@ -2,23 +2,27 @@ package auth
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/rs/zerolog/log"
 
 	"github.com/forbole/callisto/v4/types"
 )
 
-// GetGenesisAccounts parses the given appState and returns the genesis accounts
-func GetGenesisAccounts(appState map[string]json.RawMessage, cdc codec.Codec) ([]types.Account, error) {
+// GetGenesisAccounts parses the given appState and returns the details of the genesis accounts
+func GetGenesisAccounts(
+	appState map[string]json.RawMessage, cdc codec.Codec, height int64,
+) ([]types.AccountDetails, error) {
 	var authState authttypes.GenesisState
 	if err := cdc.UnmarshalJSON(appState[authttypes.ModuleName], &authState); err != nil {
 		return nil, err
 	}
 
 	// Store the accounts
-	accounts := make([]types.Account, len(authState.Accounts))
+	accounts := make([]types.AccountDetails, len(authState.Accounts))
 	for index, account := range authState.Accounts {
 		var accountI authttypes.AccountI
 		err := cdc.UnpackAny(account, &accountI)
@@ -26,7 +30,10 @@ func GetGenesisAccounts(appState map[string]json.RawMessage, cdc codec.Codec) ([
 			return nil, err
 		}
 
-		accounts[index] = types.NewAccount(accountI.GetAddress().String())
+		accounts[index], err = GetAccountDetails(accountI, cdc, height)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return accounts, nil
@@ -34,25 +41,87 @@ func GetGenesisAccounts(appState map[string]json.RawMessage, cdc codec.Codec) ([
 
 // --------------------------------------------------------------------------------------------------------------------
 
-// GetAccounts returns the account data for the given addresses
-func GetAccounts(height int64, addresses []string) []types.Account {
+// GetAccountDetails returns the details of the given account, seen at the given height
+func GetAccountDetails(account authttypes.AccountI, cdc codec.Codec, height int64) (types.AccountDetails, error) {
+	var pubKeyType string
+	var pubKeyBz []byte
+	if pubKey := account.GetPubKey(); pubKey != nil {
+		bz, err := cdc.MarshalJSON(pubKey)
+		if err != nil {
+			return types.AccountDetails{}, fmt.Errorf("error while serializing public key: %s", err)
+		}
+		pubKeyType, pubKeyBz = proto.MessageName(pubKey), bz
+	}
+
+	var moduleName string
+	var modulePermissions []string
+	if moduleAccount, ok := account.(authttypes.ModuleAccountI); ok {
+		moduleName, modulePermissions = moduleAccount.GetName(), moduleAccount.GetPermissions()
+	}
+
+	return types.NewAccountDetails(
+		account.GetAddress().String(),
+		proto.MessageName(account),
+		pubKeyType,
+		pubKeyBz,
+		account.GetAccountNumber(),
+		account.GetSequence(),
+		moduleName,
+		modulePermissions,
+		height,
+	), nil
+}
+
+// GetAccounts returns the details of the accounts associated with the given addresses at the given height.
+// Addresses that are not associated with any account on chain are returned with empty details
+func (m *Module) GetAccounts(height int64, addresses []string) ([]types.AccountDetails, error) {
 	log.Debug().Str("module", "auth").Str("operation", "accounts").Msg("getting accounts data")
 
-	// Get all the accounts information
-	var accounts = make([]types.Account, len(addresses))
+	accounts, err := m.source.GetAccounts(addresses, height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting accounts: %s", err)
+	}
+
+	details := make(map[string]types.AccountDetails, len(accounts))
+	for _, account := range accounts {
+		accountDetails, err := GetAccountDetails(account, m.cdc, height)
+		if err != nil {
+			return nil, err
+		}
+		details[accountDetails.Address] = accountDetails
+	}
+
+	result := make([]types.AccountDetails, len(addresses))
 	for index, address := range addresses {
-		accounts[index] = types.NewAccount(address)
+		accountDetails, ok := details[address]
+		if !ok {
+			accountDetails = types.NewAccountDetails(address, "", "", nil, 0, 0, "", nil, height)
+		}
+		result[index] = accountDetails
 	}
 
-	return accounts
+	return result, nil
 }
 
 // RefreshAccounts takes the given addresses and for each one queries the chain
 // retrieving the account data and stores it inside the database.
+// If the chain cannot be queried, only the addresses are stored so that the accounts are not lost
 func (m *Module) RefreshAccounts(height int64, addresses []string) error {
 	if len(addresses) == 0 {
 		return nil
 	}
-	accounts := GetAccounts(height, addresses)
-	return m.db.SaveAccounts(accounts)
+
+	accounts, err := m.GetAccounts(height, addresses)
+	if err != nil {
+		log.Error().Str("module", "auth").Err(err).Int64("height", height).
+			Msg("error while getting accounts details, storing only their addresses")
+
+		basicAccounts := make([]types.Account, len(addresses))
+		for index, address := range addresses {
+			basicAccounts[index] = types.NewAccount(address)
+		}
+		return m.db.SaveAccounts(basicAccounts)
+	}
+
+	return m.db.SaveAccountsDetails(accounts)
 }
@@ -10,14 +10,14 @@ import (
 )
 
 // HandleGenesis implements modules.GenesisModule
-func (m *Module) HandleGenesis(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+func (m *Module) HandleGenesis(doc *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
 	log.Debug().Str("module", "auth").Msg("parsing genesis")
 
-	accounts, err := GetGenesisAccounts(appState, m.cdc)
+	accounts, err := GetGenesisAccounts(appState, m.cdc, doc.InitialHeight)
 	if err != nil {
 		return fmt.Errorf("error while getting genesis accounts: %s", err)
 	}
-	err = m.db.SaveAccounts(accounts)
+	err = m.db.SaveAccountsDetails(accounts)
 	if err != nil {
 		return fmt.Errorf("error while storing genesis accounts: %s", err)
 	}
@@ -5,16 +5,13 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/gogoproto/proto"
 	juno "github.com/forbole/juno/v5/types"
-	"github.com/rs/zerolog/log"
 
 	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
-
-	"github.com/forbole/callisto/v4/modules/utils"
-	"github.com/forbole/callisto/v4/types"
 )
 
 // HandleMsgExec implements modules.AuthzMessageModule
@@ -24,48 +21,93 @@ func (m *Module) HandleMsgExec(index int, _ *authz.MsgExec, _ int, executedMsg s
 
 // HandleMsg implements modules.MessageModule
 func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
-	addresses, err := m.messagesParser(tx)
+	return m.HandleVestingMsg(msg, tx)
+}
+
+// HandleVestingMsg stores the vesting account created by the given message, if it is a vesting account creation one
+func (m *Module) HandleVestingMsg(msg sdk.Msg, tx *juno.Tx) error {
+	// Failed transactions do not create any vesting account
+	if len(tx.Logs) == 0 {
+		return nil
+	}
+
+	var vestingAccount exported.VestingAccount
+	var err error
+
+	switch cosmosMsg := msg.(type) {
+	case *vestingtypes.MsgCreateVestingAccount:
+		vestingAccount, err = getVestingAccountFromMsg(cosmosMsg, tx)
+	case *vestingtypes.MsgCreatePeriodicVestingAccount:
+		vestingAccount, err = getPeriodicVestingAccountFromMsg(cosmosMsg)
+	case *vestingtypes.MsgCreatePermanentLockedAccount:
+		vestingAccount, err = getPermanentLockedAccountFromMsg(cosmosMsg)
+	default:
+		return nil
+	}
+
 	if err != nil {
-		log.Error().Str("module", "auth").Err(err).
-			Str("operation", "refresh account").
-			Msgf("error while refreshing accounts after message of type %s", proto.MessageName(msg))
+		return fmt.Errorf("error while handling %s: %s", proto.MessageName(msg), err)
 	}
 
-	if cosmosMsg, ok := msg.(*vestingtypes.MsgCreateVestingAccount); ok {
-		// Store tx timestamp as start_time of the created vesting account
-		timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
-		if err != nil {
-			return fmt.Errorf("error while parsing time: %s", err)
-		}
-
-		err = m.handleMsgCreateVestingAccount(cosmosMsg, timestamp)
-		if err != nil {
-			return fmt.Errorf("error while handling MsgCreateVestingAccount %s", err)
-		}
+	err = m.db.SaveVestingAccounts([]exported.VestingAccount{vestingAccount})
+	if err != nil {
+		return fmt.Errorf("error while storing vesting account from %s: %s", proto.MessageName(msg), err)
 	}
 
-	return m.RefreshAccounts(tx.Height, utils.FilterNonAccountAddresses(addresses))
+	return nil
 }
 
-func (m *Module) handleMsgCreateVestingAccount(msg *vestingtypes.MsgCreateVestingAccount, txTimestamp time.Time) error {
+// getVestingAccountFromMsg returns the continuous or delayed vesting account created by the given message.
+// The tx timestamp is used as the start time of continuous vesting accounts
+func getVestingAccountFromMsg(msg *vestingtypes.MsgCreateVestingAccount, tx *juno.Tx) (exported.VestingAccount, error) {
+	baseAccount, err := getBaseAccount(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Delayed {
+		return vestingtypes.NewDelayedVestingAccount(baseAccount, msg.Amount, msg.EndTime), nil
+	}
 
-	accAddress, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
 	if err != nil {
-		return fmt.Errorf("error while converting account address %s", err)
+		return nil, fmt.Errorf("error while parsing time: %s", err)
 	}
 
-	// store account in database
-	err = m.db.SaveAccounts([]types.Account{types.NewAccount(accAddress.String())})
+	return vestingtypes.NewContinuousVestingAccount(baseAccount, msg.Amount, timestamp.Unix(), msg.EndTime), nil
+}
+
+// getPeriodicVestingAccountFromMsg returns the periodic vesting account created by the given message
+func getPeriodicVestingAccountFromMsg(msg *vestingtypes.MsgCreatePeriodicVestingAccount) (exported.VestingAccount, error) {
+	baseAccount, err := getBaseAccount(msg.ToAddress)
 	if err != nil {
-		return fmt.Errorf("error while storing vesting account: %s", err)
+		return nil, err
+	}
+
+	var totalCoins sdk.Coins
+	for _, period := range msg.VestingPeriods {
+		totalCoins = totalCoins.Add(period.Amount...)
 	}
 
-	bva := vestingtypes.NewBaseVestingAccount(
-		authttypes.NewBaseAccountWithAddress(accAddress), msg.Amount, msg.EndTime,
-	)
-	err = m.db.StoreBaseVestingAccountFromMsg(bva, txTimestamp)
+	return vestingtypes.NewPeriodicVestingAccount(baseAccount, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods), nil
+}
+
+// getPermanentLockedAccountFromMsg returns the permanent locked account created by the given message
+func getPermanentLockedAccountFromMsg(msg *vestingtypes.MsgCreatePermanentLockedAccount) (exported.VestingAccount, error) {
+	baseAccount, err := getBaseAccount(msg.ToAddress)
 	if err != nil {
-		return fmt.Errorf("error while storing base vesting account from msg %s", err)
+		return nil, err
 	}
-	return nil
+
+	return vestingtypes.NewPermanentLockedAccount(baseAccount, msg.Amount), nil
+}
+
+// getBaseAccount returns the base account associated with the given address
+func getBaseAccount(address string) (*authttypes.BaseAccount, error) {
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, fmt.Errorf("error while converting account address %s", err)
+	}
+
+	return authttypes.NewBaseAccountWithAddress(accAddress), nil
 }
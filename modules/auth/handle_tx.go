@@ -0,0 +1,23 @@
+package auth
+
+import (
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+	callistoutils "github.com/forbole/callisto/v4/utils"
+)
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(tx *juno.Tx) error {
+	// Refresh the accounts involved in any of the transaction messages only once per transaction
+	addresses, err := m.messagesParser(tx)
+	if err != nil {
+		log.Error().Str("module", "auth").Err(err).Int64("height", tx.Height).
+			Str("operation", "refresh accounts").
+			Msgf("error while getting the addresses involved in transaction %s", tx.TxHash)
+	}
+
+	addresses = utils.FilterNonAccountAddresses(callistoutils.RemoveDuplicateValues(addresses))
+	return m.RefreshAccounts(tx.Height, addresses)
+}
@@ -4,15 +4,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	"github.com/forbole/callisto/v4/database"
+	"github.com/forbole/callisto/v4/modules/auth/source"
 
 	"github.com/forbole/juno/v5/modules"
 	"github.com/forbole/juno/v5/modules/messages"
 )
 
 var (
-	_ modules.Module        = &Module{}
-	_ modules.GenesisModule = &Module{}
-	_ modules.MessageModule = &Module{}
+	_ modules.Module            = &Module{}
+	_ modules.GenesisModule     = &Module{}
+	_ modules.MessageModule     = &Module{}
+	_ modules.TransactionModule = &Module{}
 )
 
 // Module represents the x/auth module
@@ -20,11 +22,15 @@ type Module struct {
 	cdc            codec.Codec
 	db             *database.Db
 	messagesParser messages.MessageAddressesParser
+	source         source.Source
 }
 
 // NewModule builds a new Module instance
-func NewModule(messagesParser messages.MessageAddressesParser, cdc codec.Codec, db *database.Db) *Module {
+func NewModule(
+	source source.Source, messagesParser messages.MessageAddressesParser, cdc codec.Codec, db *database.Db,
+) *Module {
 	return &Module{
+		source:         source,
 		messagesParser: messagesParser,
 		cdc:            cdc,
 		db:             db,
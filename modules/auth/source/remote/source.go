@@ -0,0 +1,59 @@
+package remote
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/forbole/juno/v5/node/remote"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authsource "github.com/forbole/callisto/v4/modules/auth/source"
+)
+
+var (
+	_ authsource.Source = &Source{}
+)
+
+// Source implements authsource.Source using a remote node
+type Source struct {
+	*remote.Source
+	authClient authtypes.QueryClient
+	cdc        codec.Codec
+}
+
+// NewSource returns a new Source instance
+func NewSource(source *remote.Source, authClient authtypes.QueryClient, cdc codec.Codec) *Source {
+	return &Source{
+		Source:     source,
+		authClient: authClient,
+		cdc:        cdc,
+	}
+}
+
+// GetAccounts implements authsource.Source
+func (s Source) GetAccounts(addresses []string, height int64) ([]authtypes.AccountI, error) {
+	ctx := remote.GetHeightRequestContext(s.Ctx, height)
+
+	var accounts []authtypes.AccountI
+	for _, address := range addresses {
+		res, err := s.authClient.Account(ctx, &authtypes.QueryAccountRequest{Address: address})
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error while getting account %s: %s", address, err)
+		}
+
+		var account authtypes.AccountI
+		err = s.cdc.UnpackAny(res.Account, &account)
+		if err != nil {
+			return nil, fmt.Errorf("error while unpacking account %s: %s", address, err)
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
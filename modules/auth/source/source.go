@@ -0,0 +1,11 @@
+package source
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type Source interface {
+	// GetAccounts returns the accounts associated with the given addresses at the given height.
+	// Addresses that are not associated with any account on chain are skipped
+	GetAccounts(addresses []string, height int64) ([]authtypes.AccountI, error)
+}
@@ -0,0 +1,66 @@
+package authz
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/gogoproto/proto"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// HandleBlock implements BlockModule
+func (m *Module) HandleBlock(
+	block *tmctypes.ResultBlock, res *tmctypes.ResultBlockResults, _ []*juno.Tx, _ *tmctypes.ResultValidators,
+) error {
+	// Remove the grants revoked by the chain itself
+	var events []abci.Event
+	events = append(events, res.BeginBlockEvents...)
+	events = append(events, res.EndBlockEvents...)
+
+	err := m.RemoveRevokedGrants(block.Block.Height, events)
+	if err != nil {
+		return fmt.Errorf("error while removing revoked authz grants: %s", err)
+	}
+
+	// Remove the grants that are expired at this block time
+	err = m.db.DeleteExpiredAuthzGrants(block.Block.Time)
+	if err != nil {
+		return fmt.Errorf("error while removing expired authz grants: %s", err)
+	}
+
+	return nil
+}
+
+// RemoveRevokedGrants removes from the database all the grants revoked inside the given events
+func (m *Module) RemoveRevokedGrants(height int64, events []abci.Event) error {
+	log.Debug().Str("module", "authz").Int64("height", height).
+		Msg("removing revoked authz grants")
+
+	events = juno.FindEventsByType(events, proto.MessageName(&authztypes.EventRevoke{}))
+	for _, event := range events {
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return fmt.Errorf("error while parsing authz revoke event: %s", err)
+		}
+
+		revokeEvent, ok := typedEvent.(*authztypes.EventRevoke)
+		if !ok {
+			continue
+		}
+
+		err = m.db.DeleteAuthzGrant(types.NewAuthzGrantRemoval(
+			revokeEvent.Granter, revokeEvent.Grantee, revokeEvent.MsgTypeUrl, height,
+		))
+		if err != nil {
+			return fmt.Errorf("error while deleting authz grant: %s", err)
+		}
+	}
+
+	return nil
+}
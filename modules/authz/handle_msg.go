@@ -0,0 +1,99 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// HandleMsgExec implements modules.AuthzMessageModule
+func (m *Module) HandleMsgExec(
+	index int, msgExec *authztypes.MsgExec, authzMsgIndex int, executedMsg sdk.Msg, tx *juno.Tx,
+) error {
+	if len(tx.Logs) == 0 {
+		return nil
+	}
+
+	// Grants fully used while executing the messages are revoked by the chain, so we remove them
+	// when handling the last message, regardless of whether such message can be handled
+	var revokeErr error
+	if authzMsgIndex == len(msgExec.Msgs)-1 {
+		revokeErr = m.RemoveRevokedGrants(tx.Height, tx.Events)
+	}
+
+	err := m.handleExecutedMsg(index, msgExec, authzMsgIndex, executedMsg, tx)
+	if err != nil && revokeErr != nil {
+		return fmt.Errorf("%s; error while removing revoked authz grants: %s", err, revokeErr)
+	}
+	if err != nil {
+		return err
+	}
+	if revokeErr != nil {
+		return fmt.Errorf("error while removing revoked authz grants: %s", revokeErr)
+	}
+
+	return nil
+}
+
+// handleExecutedMsg stores the usage of the grant that allowed the execution of the given message,
+// and then handles the message itself
+func (m *Module) handleExecutedMsg(
+	index int, msgExec *authztypes.MsgExec, authzMsgIndex int, executedMsg sdk.Msg, tx *juno.Tx,
+) error {
+	// Messages that could not be decoded or that have no signer cannot be linked to any grant
+	if executedMsg == nil || len(executedMsg.GetSigners()) == 0 {
+		return nil
+	}
+
+	// Messages signed by the grantee itself do not require any grant
+	granter := executedMsg.GetSigners()[0].String()
+	if granter != msgExec.Grantee {
+		err := m.db.SaveAuthzGrantUsages([]types.AuthzGrantUsage{
+			types.NewAuthzGrantUsage(
+				tx.TxHash, index, authzMsgIndex, granter, msgExec.Grantee, sdk.MsgTypeURL(executedMsg), tx.Height,
+			),
+		})
+		if err != nil {
+			return fmt.Errorf("error while saving authz grant usage: %s", err)
+		}
+	}
+
+	return m.HandleMsg(index, executedMsg, tx)
+}
+
+// HandleMsg implements modules.MessageModule
+func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
+	if len(tx.Logs) == 0 {
+		return nil
+	}
+
+	switch cosmosMsg := msg.(type) {
+	case *authztypes.MsgGrant:
+		return m.HandleMsgGrant(tx, cosmosMsg)
+	case *authztypes.MsgRevoke:
+		return m.HandleMsgRevoke(tx, cosmosMsg)
+	}
+
+	return nil
+}
+
+// HandleMsgGrant allows to properly handle a MsgGrant
+func (m *Module) HandleMsgGrant(tx *juno.Tx, msg *authztypes.MsgGrant) error {
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return fmt.Errorf("error while getting authorization: %s", err)
+	}
+
+	return m.db.SaveAuthzGrant(types.NewAuthzGrant(
+		msg.Granter, msg.Grantee, authorization, msg.Grant.Expiration, tx.Height,
+	))
+}
+
+// HandleMsgRevoke allows to properly handle a MsgRevoke
+func (m *Module) HandleMsgRevoke(tx *juno.Tx, msg *authztypes.MsgRevoke) error {
+	return m.db.DeleteAuthzGrant(types.NewAuthzGrantRemoval(msg.Granter, msg.Grantee, msg.MsgTypeUrl, tx.Height))
+}
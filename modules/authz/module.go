@@ -0,0 +1,35 @@
+package authz
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/forbole/callisto/v4/database"
+
+	"github.com/forbole/juno/v5/modules"
+)
+
+var (
+	_ modules.BlockModule        = &Module{}
+	_ modules.Module             = &Module{}
+	_ modules.MessageModule      = &Module{}
+	_ modules.AuthzMessageModule = &Module{}
+)
+
+// Module represent x/authz module
+type Module struct {
+	cdc codec.Codec
+	db  *database.Db
+}
+
+// NewModule returns a new Module instance
+func NewModule(cdc codec.Codec, db *database.Db) *Module {
+	return &Module{
+		cdc: cdc,
+		db:  db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "authz"
+}
@@ -0,0 +1,97 @@
+package bank
+
+import (
+	"fmt"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	SupplyHistoryIntervalHour = "hour"
+	SupplyHistoryIntervalDay  = "day"
+)
+
+// Config contains the configuration about the bank module
+type Config struct {
+	SupplyHistoryInterval string                  `yaml:"supply_history_interval"`
+	CirculatingSupply     CirculatingSupplyConfig `yaml:"circulating_supply"`
+}
+
+// CirculatingSupplyConfig contains the configuration about the amounts that should be
+// excluded from the total supply while computing the circulating supply
+type CirculatingSupplyConfig struct {
+	// ExcludedModuleAccounts contains the names of the module accounts whose balances should be excluded.
+	// The community pool is always excluded, so the distribution module account should not be listed here
+	ExcludedModuleAccounts []string `yaml:"excluded_module_accounts"`
+
+	// ExcludedAddresses contains the addresses (e.g. treasury accounts) whose balances should be excluded
+	ExcludedAddresses []string `yaml:"excluded_addresses"`
+}
+
+// NewCirculatingSupplyConfig returns a new CirculatingSupplyConfig instance
+func NewCirculatingSupplyConfig(excludedModuleAccounts []string, excludedAddresses []string) CirculatingSupplyConfig {
+	return CirculatingSupplyConfig{
+		ExcludedModuleAccounts: excludedModuleAccounts,
+		ExcludedAddresses:      excludedAddresses,
+	}
+}
+
+// NewConfig returns a new Config instance
+func NewConfig(supplyHistoryInterval string, circulatingSupply CirculatingSupplyConfig) *Config {
+	return &Config{
+		SupplyHistoryInterval: supplyHistoryInterval,
+		CirculatingSupply:     circulatingSupply,
+	}
+}
+
+// DefaultConfig returns the default configuration
+func DefaultConfig() *Config {
+	return NewConfig(SupplyHistoryIntervalDay, NewCirculatingSupplyConfig(nil, nil))
+}
+
+// Validate checks that the configuration contains valid values
+func (c *Config) Validate() error {
+	switch c.SupplyHistoryInterval {
+	case SupplyHistoryIntervalHour, SupplyHistoryIntervalDay:
+		return nil
+	default:
+		return fmt.Errorf("invalid supply history interval: %s", c.SupplyHistoryInterval)
+	}
+}
+
+// GetSupplyHistoryTimestamp returns the beginning of the supply history bucket the given time falls into
+func (c *Config) GetSupplyHistoryTimestamp(t time.Time) time.Time {
+	if c.SupplyHistoryInterval == SupplyHistoryIntervalHour {
+		return t.UTC().Truncate(time.Hour)
+	}
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// GetExcludedModuleAddresses returns the addresses of the module accounts that should be excluded
+// while computing the circulating supply
+func (c *Config) GetExcludedModuleAddresses() []string {
+	addresses := make([]string, len(c.CirculatingSupply.ExcludedModuleAccounts))
+	for i, name := range c.CirculatingSupply.ExcludedModuleAccounts {
+		addresses[i] = authtypes.NewModuleAddress(name).String()
+	}
+	return addresses
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"bank"`
+	}
+	cfg := T{Config: DefaultConfig()}
+	err := yaml.Unmarshal(bz, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Config == nil {
+		return DefaultConfig(), nil
+	}
+
+	return cfg.Config, cfg.Config.Validate()
+}
@@ -0,0 +1,91 @@
+package bank_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/bank"
+)
+
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    string
+		expected  *bank.Config
+		shouldErr bool
+	}{
+		{
+			"all the values are parsed",
+			`
+bank:
+  supply_history_interval: hour
+  circulating_supply:
+    excluded_module_accounts: ["gov"]
+    excluded_addresses: ["cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2"]
+`,
+			bank.NewConfig(
+				bank.SupplyHistoryIntervalHour,
+				bank.NewCirculatingSupplyConfig([]string{"gov"}, []string{"cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2"}),
+			),
+			false,
+		},
+		{
+			"missing circulating supply keeps its default",
+			`
+bank:
+  supply_history_interval: hour
+`,
+			bank.NewConfig(bank.SupplyHistoryIntervalHour, bank.NewCirculatingSupplyConfig(nil, nil)),
+			false,
+		},
+		{
+			"missing supply history interval keeps its default",
+			`
+bank:
+  circulating_supply:
+    excluded_module_accounts: ["gov"]
+`,
+			bank.NewConfig(bank.SupplyHistoryIntervalDay, bank.NewCirculatingSupplyConfig([]string{"gov"}, nil)),
+			false,
+		},
+		{
+			"missing section returns the default config",
+			`chain: {}`,
+			bank.DefaultConfig(),
+			false,
+		},
+		{
+			"invalid supply history interval returns error",
+			`
+bank:
+  supply_history_interval: week
+`,
+			nil,
+			true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := bank.ParseConfig([]byte(test.config))
+			if test.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
+
+func TestConfig_GetSupplyHistoryTimestamp(t *testing.T) {
+	timestamp := time.Date(2024, 3, 15, 13, 45, 12, 0, time.UTC)
+
+	hourly := bank.NewConfig(bank.SupplyHistoryIntervalHour, bank.NewCirculatingSupplyConfig(nil, nil))
+	require.Equal(t, time.Date(2024, 3, 15, 13, 0, 0, 0, time.UTC), hourly.GetSupplyHistoryTimestamp(timestamp))
+
+	daily := bank.NewConfig(bank.SupplyHistoryIntervalDay, bank.NewCirculatingSupplyConfig(nil, nil))
+	require.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), daily.GetSupplyHistoryTimestamp(timestamp))
+}
@@ -0,0 +1,54 @@
+package bank
+
+import (
+	"fmt"
+
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+	callistoutils "github.com/forbole/callisto/v4/utils"
+)
+
+// HandleBlock implements modules.BlockModule
+func (m *Module) HandleBlock(
+	block *tmctypes.ResultBlock, res *tmctypes.ResultBlockResults, txs []*juno.Tx, _ *tmctypes.ResultValidators,
+) error {
+	addresses := GetBalanceChangedAddresses(sdk.StringifyEvents(res.BeginBlockEvents))
+	addresses = append(addresses, GetBalanceChangedAddresses(sdk.StringifyEvents(res.EndBlockEvents))...)
+
+	for _, tx := range txs {
+		// Fee payments are not part of the messages logs, so we need to read them from the tx events
+		addresses = append(addresses, GetBalanceChangedAddresses(sdk.StringifyEvents(tx.Events))...)
+		for _, msgLog := range tx.Logs {
+			addresses = append(addresses, GetBalanceChangedAddresses(msgLog.Events)...)
+		}
+	}
+
+	err := m.UpdateBalances(block.Block.Height, callistoutils.RemoveDuplicateValues(addresses))
+	if err != nil {
+		return fmt.Errorf("error while updating account balances: %s", err)
+	}
+
+	return nil
+}
+
+// UpdateBalances refreshes the balances of the accounts having the given addresses at the provided height
+func (m *Module) UpdateBalances(height int64, addresses []string) error {
+	addresses = utils.FilterNonAccountAddresses(addresses)
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	log.Debug().Str("module", "bank").Int64("height", height).
+		Int("accounts", len(addresses)).Msg("updating account balances")
+
+	balances, err := m.keeper.GetBalances(addresses, height)
+	if err != nil {
+		return fmt.Errorf("error while getting account balances: %s", err)
+	}
+
+	return m.db.SaveAccountBalances(balances)
+}
@@ -0,0 +1,30 @@
+package bank
+
+import (
+	"encoding/json"
+	"fmt"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/rs/zerolog/log"
+)
+
+// HandleGenesis implements modules.GenesisModule
+func (m *Module) HandleGenesis(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+	log.Debug().Str("module", "bank").Msg("parsing genesis")
+
+	// Read the genesis state
+	var genState banktypes.GenesisState
+	err := m.cdc.UnmarshalJSON(appState[banktypes.ModuleName], &genState)
+	if err != nil {
+		return fmt.Errorf("error while reading bank genesis data: %s", err)
+	}
+
+	// Save the denoms metadata
+	err = m.saveDenomsMetadata(genState.DenomMetadata)
+	if err != nil {
+		return fmt.Errorf("error while storing genesis denoms metadata: %s", err)
+	}
+
+	return nil
+}
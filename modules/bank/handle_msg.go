@@ -0,0 +1,84 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// HandleMsgExec implements modules.AuthzMessageModule
+func (m *Module) HandleMsgExec(index int, msgExec *authz.MsgExec, authzMsgIndex int, executedMsg sdk.Msg, tx *juno.Tx) error {
+	if len(tx.Logs) == 0 {
+		return nil
+	}
+
+	// Count the transfers of the previous executed messages so that each one of them gets a unique leg index
+	executedMsgs, err := msgExec.GetMessages()
+	if err != nil {
+		return fmt.Errorf("error while getting MsgExec messages: %s", err)
+	}
+
+	legOffset := 0
+	for _, msg := range executedMsgs[:authzMsgIndex] {
+		transfers, err := GetTokenTransfers(tx, index, 0, msg)
+		if err != nil {
+			return err
+		}
+		legOffset += len(transfers)
+	}
+
+	transfers, err := GetTokenTransfers(tx, index, legOffset, executedMsg)
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveTokenTransfers(transfers)
+}
+
+// HandleMsg implements modules.MessageModule
+func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *juno.Tx) error {
+	if len(tx.Logs) == 0 {
+		return nil
+	}
+
+	transfers, err := GetTokenTransfers(tx, index, 0, msg)
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveTokenTransfers(transfers)
+}
+
+// GetTokenTransfers returns the token transfers performed by the given message, starting the legs count from
+// the provided leg offset. If the message does not transfer any token, nil is returned instead.
+func GetTokenTransfers(tx *juno.Tx, index int, legOffset int, msg sdk.Msg) ([]types.TokenTransfer, error) {
+	switch cosmosMsg := msg.(type) {
+	case *banktypes.MsgSend:
+		return []types.TokenTransfer{
+			types.NewTokenTransfer(
+				tx.TxHash, index, legOffset, cosmosMsg.FromAddress, cosmosMsg.ToAddress, cosmosMsg.Amount, tx.Height,
+			),
+		}, nil
+
+	case *banktypes.MsgMultiSend:
+		if len(cosmosMsg.Inputs) != 1 {
+			return nil, fmt.Errorf("MsgMultiSend with %d inputs is not supported", len(cosmosMsg.Inputs))
+		}
+
+		sender := cosmosMsg.Inputs[0].Address
+		transfers := make([]types.TokenTransfer, len(cosmosMsg.Outputs))
+		for i, output := range cosmosMsg.Outputs {
+			transfers[i] = types.NewTokenTransfer(
+				tx.TxHash, index, legOffset+i, sender, output.Address, output.Coins, tx.Height,
+			)
+		}
+		return transfers, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,97 @@
+package bank_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/bank"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func TestGetTokenTransfers(t *testing.T) {
+	tx := &juno.Tx{TxResponse: &sdk.TxResponse{TxHash: "hash", Height: 10}}
+	coins := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))
+
+	tests := []struct {
+		name      string
+		msg       sdk.Msg
+		legOffset int
+		expected  []types.TokenTransfer
+		shouldErr bool
+	}{
+		{
+			"MsgSend returns a single transfer",
+			banktypes.NewMsgSend(
+				sdk.MustAccAddressFromBech32("cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2"),
+				sdk.MustAccAddressFromBech32("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"),
+				coins,
+			),
+			2,
+			[]types.TokenTransfer{
+				types.NewTokenTransfer("hash", 1, 2,
+					"cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2",
+					"cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+					coins, 10),
+			},
+			false,
+		},
+		{
+			"MsgMultiSend returns one transfer per output",
+			banktypes.NewMsgMultiSend(
+				[]banktypes.Input{{Address: "cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2", Coins: coins.Add(coins...)}},
+				[]banktypes.Output{
+					{Address: "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs", Coins: coins},
+					{Address: "cosmos1qqqqrezrl53hujmpdch6d805ac75n220ku09rl", Coins: coins},
+				},
+			),
+			0,
+			[]types.TokenTransfer{
+				types.NewTokenTransfer("hash", 1, 0,
+					"cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2",
+					"cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+					coins, 10),
+				types.NewTokenTransfer("hash", 1, 1,
+					"cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2",
+					"cosmos1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+					coins, 10),
+			},
+			false,
+		},
+		{
+			"MsgMultiSend with multiple inputs returns error",
+			banktypes.NewMsgMultiSend(
+				[]banktypes.Input{
+					{Address: "cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2", Coins: coins},
+					{Address: "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs", Coins: coins},
+				},
+				[]banktypes.Output{{Address: "cosmos1qqqqrezrl53hujmpdch6d805ac75n220ku09rl", Coins: coins.Add(coins...)}},
+			),
+			0,
+			nil,
+			true,
+		},
+		{
+			"other messages return no transfers",
+			&banktypes.MsgSetSendEnabled{},
+			0,
+			nil,
+			false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := bank.GetTokenTransfers(tx, 1, test.legOffset, test.msg)
+			if test.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, result)
+			}
+		})
+	}
+}
@@ -2,11 +2,15 @@ package bank
 
 import (
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/go-co-op/gocron"
 	"github.com/rs/zerolog/log"
 
 	"github.com/forbole/callisto/v4/modules/utils"
+	"github.com/forbole/callisto/v4/types"
 )
 
 // RegisterPeriodicOperations implements modules.Module
@@ -19,6 +23,31 @@ func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 		return fmt.Errorf("error while setting up bank periodic operation: %s", err)
 	}
 
+	// Store the supply history once every configured interval
+	historyScheduler := scheduler.Every(1).Day()
+	if m.cfg.SupplyHistoryInterval == SupplyHistoryIntervalHour {
+		historyScheduler = scheduler.Every(1).Hour()
+	}
+	if _, err := historyScheduler.Do(func() {
+		utils.WatchMethod(m.UpdateSupplyHistory)
+	}); err != nil {
+		return fmt.Errorf("error while setting up bank supply history periodic operation: %s", err)
+	}
+
+	// Update the circulating supply once every hour
+	if _, err := scheduler.Every(1).Hour().Do(func() {
+		utils.WatchMethod(m.UpdateCirculatingSupply)
+	}); err != nil {
+		return fmt.Errorf("error while setting up bank circulating supply periodic operation: %s", err)
+	}
+
+	// Update the denoms metadata once every hour
+	if _, err := scheduler.Every(1).Hour().Do(func() {
+		utils.WatchMethod(m.UpdateDenomsMetadata)
+	}); err != nil {
+		return fmt.Errorf("error while setting up bank denoms metadata periodic operation: %s", err)
+	}
+
 	return nil
 }
 
@@ -39,3 +68,145 @@ func (m *Module) UpdateSupply() error {
 
 	return m.db.SaveSupply(supply, block.Height)
 }
+
+// UpdateSupplyHistory stores the supply of all the tokens at the latest height inside the supply history
+func (m *Module) UpdateSupplyHistory() error {
+	log.Trace().Str("module", "bank").Str("operation", "supply history").
+		Msg("updating supply history")
+
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block height: %s", err)
+	}
+
+	return m.updateSupplyHistory(block.Height, block.BlockTimestamp)
+}
+
+// updateSupplyHistory stores the supply read at the given height inside the history bucket of the given time
+func (m *Module) updateSupplyHistory(height int64, timestamp time.Time) error {
+	supply, err := m.keeper.GetSupply(height)
+	if err != nil {
+		return fmt.Errorf("error while getting supply: %s", err)
+	}
+
+	return m.db.SaveSupplyHistory(supply, m.cfg.GetSupplyHistoryTimestamp(timestamp), height)
+}
+
+// BackfillSupplyHistory stores the supply history for all the buckets between the given start and end heights,
+// reading the supply at the last block stored for each one of them
+func (m *Module) BackfillSupplyHistory(startHeight int64, endHeight int64) error {
+	blocks, err := m.db.GetLastBlocksPerInterval(m.cfg.SupplyHistoryInterval, startHeight, endHeight)
+	if err != nil {
+		return err
+	}
+
+	if len(blocks) == 0 {
+		return fmt.Errorf("no blocks found between height %d and %d", startHeight, endHeight)
+	}
+
+	for _, block := range blocks {
+		log.Debug().Str("module", "bank").Int64("height", block.Height).
+			Msg("backfilling supply history")
+
+		err = m.updateSupplyHistory(block.Height, block.BlockTimestamp)
+		if err != nil {
+			return fmt.Errorf("error while updating supply history at height %d: %s", block.Height, err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateCirculatingSupply computes the circulating supply at the latest height, storing it
+// both as the current value and inside the circulating supply history
+func (m *Module) UpdateCirculatingSupply() error {
+	log.Trace().Str("module", "bank").Str("operation", "circulating supply").
+		Msg("updating circulating supply")
+
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block height: %s", err)
+	}
+
+	supply, err := m.keeper.GetSupply(block.Height)
+	if err != nil {
+		return fmt.Errorf("error while getting supply: %s", err)
+	}
+
+	// Get the balances of the excluded accounts
+	excludedAddresses := append(m.cfg.GetExcludedModuleAddresses(), m.cfg.CirculatingSupply.ExcludedAddresses...)
+	excludedBalances := sdk.NewCoins()
+	if len(excludedAddresses) > 0 {
+		balances, err := m.keeper.GetBalances(excludedAddresses, block.Height)
+		if err != nil {
+			return fmt.Errorf("error while getting excluded accounts balances: %s", err)
+		}
+
+		for _, balance := range balances {
+			excludedBalances = excludedBalances.Add(balance.Balance...)
+		}
+	}
+
+	// Get the unvested amounts
+	vestingAccounts, err := m.db.GetVestingAccounts()
+	if err != nil {
+		return fmt.Errorf("error while getting vesting accounts: %s", err)
+	}
+
+	vestingPeriods, err := m.db.GetVestingPeriods()
+	if err != nil {
+		return fmt.Errorf("error while getting vesting periods: %s", err)
+	}
+
+	unvested := GetUnvestedCoins(vestingAccounts, vestingPeriods, block.BlockTimestamp, excludedAddresses)
+
+	// Get the community pool
+	communityPool, err := m.db.GetCommunityPool()
+	if err != nil {
+		return fmt.Errorf("error while getting community pool: %s", err)
+	}
+	communityPoolCoins, _ := communityPool.TruncateDecimal()
+
+	circulatingSupply := GetCirculatingSupply(supply, unvested, communityPoolCoins, excludedBalances)
+
+	err = m.db.SaveCirculatingSupply(circulatingSupply, block.Height)
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveCirculatingSupplyHistory(
+		circulatingSupply,
+		m.cfg.GetSupplyHistoryTimestamp(block.BlockTimestamp),
+		block.Height,
+	)
+}
+
+// UpdateDenomsMetadata stores the metadata of all the denoms registered inside the bank module at the latest height
+func (m *Module) UpdateDenomsMetadata() error {
+	log.Trace().Str("module", "bank").Str("operation", "denoms metadata").
+		Msg("updating denoms metadata")
+
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block height: %s", err)
+	}
+
+	metadatas, err := m.keeper.GetDenomsMetadata(block.Height)
+	if err != nil {
+		return err
+	}
+
+	return m.saveDenomsMetadata(metadatas)
+}
+
+// saveDenomsMetadata stores the given denoms metadata as tokens and token units
+func (m *Module) saveDenomsMetadata(metadatas []banktypes.Metadata) error {
+	for _, metadata := range metadatas {
+		err := m.db.SaveTokenMetadata(types.NewTokenFromMetadata(metadata))
+		if err != nil {
+			return fmt.Errorf("error while saving metadata of denom %s: %s", metadata.Base, err)
+		}
+	}
+
+	return nil
+}
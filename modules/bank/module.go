@@ -2,6 +2,7 @@ package bank
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/forbole/juno/v5/types/config"
 
 	"github.com/forbole/callisto/v4/database"
 	"github.com/forbole/callisto/v4/modules/bank/source"
@@ -13,11 +14,16 @@ import (
 
 var (
 	_ modules.Module                   = &Module{}
+	_ modules.GenesisModule            = &Module{}
+	_ modules.BlockModule              = &Module{}
+	_ modules.MessageModule            = &Module{}
+	_ modules.AuthzMessageModule       = &Module{}
 	_ modules.PeriodicOperationsModule = &Module{}
 )
 
 // Module represents the x/bank module
 type Module struct {
+	cfg *Config
 	cdc codec.Codec
 	db  *database.Db
 
@@ -27,9 +33,20 @@ type Module struct {
 
 // NewModule returns a new Module instance
 func NewModule(
-	messageParser junomessages.MessageAddressesParser, keeper source.Source, cdc codec.Codec, db *database.Db,
+	cfg config.Config, messageParser junomessages.MessageAddressesParser, keeper source.Source, cdc codec.Codec, db *database.Db,
 ) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	bankCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
 	return &Module{
+		cfg:           bankCfg,
 		cdc:           cdc,
 		db:            db,
 		messageParser: messageParser,
@@ -82,6 +82,37 @@ func (s Source) GetSupply(height int64) (sdk.Coins, error) {
 	return coins, nil
 }
 
+// GetDenomsMetadata implements bankkeeper.Source
+func (s Source) GetDenomsMetadata(height int64) ([]banktypes.Metadata, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	var metadatas []banktypes.Metadata
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := s.q.DenomsMetadata(
+			sdk.WrapSDKContext(ctx),
+			&banktypes.QueryDenomsMetadataRequest{
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 metadata at time
+				},
+			})
+		if err != nil {
+			return nil, fmt.Errorf("error while getting denoms metadata: %s", err)
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+		metadatas = append(metadatas, res.Metadatas...)
+	}
+
+	return metadatas, nil
+}
+
 // GetAccountBalances implements bankkeeper.Source
 func (s Source) GetAccountBalance(address string, height int64) ([]sdk.Coin, error) {
 	ctx, err := s.LoadHeight(height)
@@ -2,6 +2,7 @@ package source
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/forbole/callisto/v4/types"
 )
@@ -9,6 +10,7 @@ import (
 type Source interface {
 	GetBalances(addresses []string, height int64) ([]types.AccountBalance, error)
 	GetSupply(height int64) (sdk.Coins, error)
+	GetDenomsMetadata(height int64) ([]banktypes.Metadata, error)
 
 	// -- For hasura action --
 	GetAccountBalance(address string, height int64) ([]sdk.Coin, error)
@@ -0,0 +1,93 @@
+package bank
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+)
+
+var (
+	baseVestingAccountType       = proto.MessageName(&vestingtypes.BaseVestingAccount{})
+	continuousVestingAccountType = proto.MessageName(&vestingtypes.ContinuousVestingAccount{})
+	delayedVestingAccountType    = proto.MessageName(&vestingtypes.DelayedVestingAccount{})
+	periodicVestingAccountType   = proto.MessageName(&vestingtypes.PeriodicVestingAccount{})
+	permanentLockedAccountType   = proto.MessageName(&vestingtypes.PermanentLockedAccount{})
+)
+
+// GetCirculatingSupply returns the circulating supply obtained by subtracting all the given excluded amounts
+// from the given total supply. Denoms whose excluded amount exceeds the supply are returned with a zero amount
+func GetCirculatingSupply(supply sdk.Coins, excluded ...sdk.Coins) sdk.Coins {
+	circulating := make(sdk.Coins, len(supply))
+	for i, coin := range supply {
+		amount := coin.Amount
+		for _, coins := range excluded {
+			amount = amount.Sub(coins.AmountOf(coin.Denom))
+		}
+
+		if amount.IsNegative() {
+			amount = sdk.ZeroInt()
+		}
+
+		circulating[i] = sdk.NewCoin(coin.Denom, amount)
+	}
+	return circulating
+}
+
+// GetUnvestedCoins returns the sum of the coins that are still vesting at the given time for all the given accounts.
+// Accounts whose address is contained inside the excluded addresses are skipped, since their whole balance
+// is already subtracted from the circulating supply
+func GetUnvestedCoins(
+	accounts []dbtypes.VestingAccountRow, periods []dbtypes.VestingPeriodRow, t time.Time, excludedAddresses []string,
+) sdk.Coins {
+	excluded := make(map[string]bool, len(excludedAddresses))
+	for _, address := range excludedAddresses {
+		excluded[address] = true
+	}
+
+	accountPeriods := make(map[int64]vestingtypes.Periods)
+	for _, period := range periods {
+		accountPeriods[period.VestingAccountID] = append(accountPeriods[period.VestingAccountID], vestingtypes.Period{
+			Length: period.Length,
+			Amount: period.Amount.ToCoins(),
+		})
+	}
+
+	unvested := sdk.NewCoins()
+	for _, account := range accounts {
+		if excluded[account.Address] {
+			continue
+		}
+		unvested = unvested.Add(getVestingCoins(account, accountPeriods[account.ID], t)...)
+	}
+	return unvested
+}
+
+// getVestingCoins returns the coins that are still vesting at the given time for the given account
+func getVestingCoins(account dbtypes.VestingAccountRow, periods vestingtypes.Periods, t time.Time) sdk.Coins {
+	bva := vestingtypes.NewBaseVestingAccount(nil, account.OriginalVesting.ToCoins(), account.EndTime.Unix())
+	startTime := account.StartTime.Time.Unix()
+
+	switch account.Type {
+	// Base vesting accounts are only found in databases populated before the accounts created using
+	// MsgCreateVestingAccount were stored with their concrete type, and are treated as continuous ones
+	case continuousVestingAccountType, baseVestingAccountType:
+		return vestingtypes.NewContinuousVestingAccountRaw(bva, startTime).GetVestingCoins(t)
+
+	case periodicVestingAccountType:
+		return vestingtypes.NewPeriodicVestingAccountRaw(bva, startTime, periods).GetVestingCoins(t)
+
+	case permanentLockedAccountType:
+		return bva.OriginalVesting
+
+	case delayedVestingAccountType:
+		return vestingtypes.NewDelayedVestingAccountRaw(bva).GetVestingCoins(t)
+
+	default:
+		// Unknown accounts are considered fully locked until their end time
+		return vestingtypes.NewDelayedVestingAccountRaw(bva).GetVestingCoins(t)
+	}
+}
@@ -0,0 +1,94 @@
+package bank_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/modules/bank"
+)
+
+func TestGetCirculatingSupply(t *testing.T) {
+	supply := sdk.NewCoins(
+		sdk.NewCoin("uatom", sdk.NewInt(1000)),
+		sdk.NewCoin("udesmos", sdk.NewInt(100)),
+	)
+
+	circulating := bank.GetCirculatingSupply(
+		supply,
+		sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))),
+		sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(50)), sdk.NewCoin("udesmos", sdk.NewInt(150))),
+		sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(10))),
+	)
+
+	require.Equal(t, sdk.Coins{
+		sdk.NewCoin("uatom", sdk.NewInt(850)),
+		sdk.NewCoin("udesmos", sdk.NewInt(0)),
+	}, circulating)
+}
+
+func TestGetUnvestedCoins(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(100 * time.Second)
+	now := start.Add(25 * time.Second)
+
+	newCoins := func(amount int64) *dbtypes.DbCoins {
+		coins := dbtypes.NewDbCoins(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(amount))))
+		return &coins
+	}
+
+	accounts := []dbtypes.VestingAccountRow{
+		{
+			ID:              1,
+			Type:            "cosmos.vesting.v1beta1.ContinuousVestingAccount",
+			Address:         "cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2",
+			OriginalVesting: newCoins(100),
+			StartTime:       sql.NullTime{Time: start, Valid: true},
+			EndTime:         end,
+		},
+		{
+			ID:              2,
+			Type:            "cosmos.vesting.v1beta1.DelayedVestingAccount",
+			Address:         "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+			OriginalVesting: newCoins(200),
+			StartTime:       sql.NullTime{Time: start, Valid: true},
+			EndTime:         end,
+		},
+		{
+			ID:              3,
+			Type:            "cosmos.vesting.v1beta1.PeriodicVestingAccount",
+			Address:         "cosmos1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+			OriginalVesting: newCoins(300),
+			StartTime:       sql.NullTime{Time: start, Valid: true},
+			EndTime:         end,
+		},
+		{
+			ID:              4,
+			Type:            "cosmos.vesting.v1beta1.PermanentLockedAccount",
+			Address:         "cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn",
+			OriginalVesting: newCoins(400),
+			EndTime:         time.Unix(0, 0),
+		},
+	}
+
+	periods := []dbtypes.VestingPeriodRow{
+		{VestingAccountID: 3, PeriodOrder: 0, Length: 20, Amount: newCoins(100)},
+		{VestingAccountID: 3, PeriodOrder: 1, Length: 80, Amount: newCoins(200)},
+	}
+
+	// Continuous: 75, Delayed: 200, Periodic: 200, Permanent locked: 400
+	unvested := bank.GetUnvestedCoins(accounts, periods, now, nil)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(875))), unvested)
+
+	// Excluded accounts should be skipped
+	unvested = bank.GetUnvestedCoins(accounts, periods, now, []string{"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn"})
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(475))), unvested)
+
+	// Everything should be vested after the end time, except for the permanent locked account
+	unvested = bank.GetUnvestedCoins(accounts, periods, end.Add(time.Second), nil)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(400))), unvested)
+}
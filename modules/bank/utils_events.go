@@ -0,0 +1,33 @@
+package bank
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/forbole/callisto/v4/utils"
+)
+
+// GetBalanceChangedAddresses returns the addresses of all the accounts whose balance has been changed
+// by the given events, reading them from the coin_spent and coin_received events
+func GetBalanceChangedAddresses(events sdk.StringEvents) []string {
+	var addresses []string
+	for _, event := range events {
+		var key string
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			key = banktypes.AttributeKeySpender
+		case banktypes.EventTypeCoinReceived:
+			key = banktypes.AttributeKeyReceiver
+		default:
+			continue
+		}
+
+		for _, attribute := range event.Attributes {
+			if attribute.Key == key {
+				addresses = append(addresses, attribute.Value)
+			}
+		}
+	}
+
+	return utils.RemoveDuplicateValues(addresses)
+}
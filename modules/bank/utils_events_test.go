@@ -0,0 +1,79 @@
+package bank_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/bank"
+)
+
+func TestGetBalanceChangedAddresses(t *testing.T) {
+	tests := []struct {
+		name     string
+		events   sdk.StringEvents
+		expected []string
+	}{
+		{
+			"coin spent and coin received events return properly",
+			sdk.StringEvents{
+				sdk.StringEvent{
+					Type: banktypes.EventTypeCoinSpent,
+					Attributes: []sdk.Attribute{
+						sdk.NewAttribute(banktypes.AttributeKeySpender, "cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2"),
+						sdk.NewAttribute(sdk.AttributeKeyAmount, "100uatom"),
+					},
+				},
+				sdk.StringEvent{
+					Type: banktypes.EventTypeCoinReceived,
+					Attributes: []sdk.Attribute{
+						sdk.NewAttribute(banktypes.AttributeKeyReceiver, "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"),
+						sdk.NewAttribute(sdk.AttributeKeyAmount, "100uatom"),
+					},
+				},
+			},
+			[]string{
+				"cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2",
+				"cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+			},
+		},
+		{
+			"duplicated addresses are returned once",
+			sdk.StringEvents{
+				sdk.StringEvent{
+					Type: banktypes.EventTypeCoinSpent,
+					Attributes: []sdk.Attribute{
+						sdk.NewAttribute(banktypes.AttributeKeySpender, "cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2"),
+						sdk.NewAttribute(sdk.AttributeKeyAmount, "100uatom"),
+						sdk.NewAttribute(banktypes.AttributeKeySpender, "cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2"),
+						sdk.NewAttribute(sdk.AttributeKeyAmount, "10uatom"),
+					},
+				},
+			},
+			[]string{
+				"cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2",
+			},
+		},
+		{
+			"other events are ignored",
+			sdk.StringEvents{
+				sdk.StringEvent{
+					Type: banktypes.EventTypeTransfer,
+					Attributes: []sdk.Attribute{
+						sdk.NewAttribute(banktypes.AttributeKeyRecipient, "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"),
+					},
+				},
+			},
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := bank.GetBalanceChangedAddresses(test.events)
+			require.Equal(t, test.expected, result)
+		})
+	}
+}
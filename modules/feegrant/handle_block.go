@@ -22,7 +22,15 @@ func (m *Module) HandleBlock(
 	// Remove expired fee grant allowances
 	err := m.removeExpiredFeeGrantAllowances(block.Block.Height, res.EndBlockEvents)
 	if err != nil {
-		fmt.Printf("Error when removing expired fee grant allowance, error: %s", err)
+		log.Error().Str("module", "feegrant").Err(err).Int64("height", block.Block.Height).
+			Msg("error while removing expired fee grant allowances")
+	}
+
+	// Expired allowances are pruned by the chain without emitting any event, so we rely on their expiration
+	err = m.db.DeleteExpiredFeeGrantAllowances(block.Block.Time, block.Block.Height)
+	if err != nil {
+		log.Error().Str("module", "feegrant").Err(err).Int64("height", block.Block.Height).
+			Msg("error while deleting fee grant allowances past their expiration")
 	}
 	return nil
 }
@@ -43,7 +51,9 @@ func (m *Module) removeExpiredFeeGrantAllowances(height int64, events []abci.Eve
 		if err != nil {
 			return fmt.Errorf("error while getting fee grant grantee address: %s", err)
 		}
-		err = m.db.DeleteFeeGrantAllowance(types.NewGrantRemoval(granteeAddress.Value, granterAddress.Value, height))
+		err = m.db.DeleteFeeGrantAllowance(types.NewGrantRemoval(
+			granteeAddress.Value, granterAddress.Value, types.FeeGrantStatusExpired, "", height,
+		))
 		if err != nil {
 			return fmt.Errorf("error while deleting fee grant allowance: %s", err)
 
@@ -50,10 +50,18 @@ func (m *Module) HandleMsgGrantAllowance(tx *juno.Tx, msg *feegranttypes.MsgGran
 	if err != nil {
 		return fmt.Errorf("error while getting new grant allowance: %s", err)
 	}
-	return m.db.SaveFeeGrantAllowance(types.NewFeeGrant(feeGrant, tx.Height))
+
+	err = m.db.SaveFeeGrantAllowance(types.NewFeeGrant(feeGrant, tx.Height))
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveFeeGrantAllowanceHistory(types.NewFeeGrant(feeGrant, tx.Height), types.FeeGrantStatusGranted, tx.TxHash)
 }
 
 // HandleMsgRevokeAllowance allows to properly handle a MsgRevokeAllowance
 func (m *Module) HandleMsgRevokeAllowance(tx *juno.Tx, msg *feegranttypes.MsgRevokeAllowance) error {
-	return m.db.DeleteFeeGrantAllowance(types.NewGrantRemoval(msg.Grantee, msg.Granter, tx.Height))
+	return m.db.DeleteFeeGrantAllowance(types.NewGrantRemoval(
+		msg.Grantee, msg.Granter, types.FeeGrantStatusRevoked, tx.TxHash, tx.Height,
+	))
 }
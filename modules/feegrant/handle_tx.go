@@ -0,0 +1,89 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(tx *juno.Tx) error {
+	if tx.AuthInfo == nil || tx.AuthInfo.Fee == nil || tx.AuthInfo.Fee.Granter == "" {
+		return nil
+	}
+
+	// Fees are deducted before executing the messages, so the allowance is used even when the transaction fails
+	for index, event := range tx.Events {
+		if event.Type != feegranttypes.EventTypeUseFeeGrant {
+			continue
+		}
+
+		granter, err := juno.FindAttributeByKey(event, feegranttypes.AttributeKeyGranter)
+		if err != nil {
+			return fmt.Errorf("error while getting fee grant granter address: %s", err)
+		}
+		grantee, err := juno.FindAttributeByKey(event, feegranttypes.AttributeKeyGrantee)
+		if err != nil {
+			return fmt.Errorf("error while getting fee grant grantee address: %s", err)
+		}
+
+		err = m.db.SaveFeeGrantUsage(types.NewFeeGrantUsage(
+			tx.TxHash, grantee.Value, granter.Value, tx.AuthInfo.Fee.Amount, tx.Height,
+		))
+		if err != nil {
+			return fmt.Errorf("error while saving fee grant usage: %s", err)
+		}
+
+		// When the spend limit is fully used, the allowance is revoked right before emitting the use event
+		if index > 0 && tx.Events[index-1].Type == feegranttypes.EventTypeRevokeFeeGrant {
+			err = m.db.DeleteFeeGrantAllowance(types.NewGrantRemoval(
+				grantee.Value, granter.Value, types.FeeGrantStatusExhausted, tx.TxHash, tx.Height,
+			))
+			if err != nil {
+				return fmt.Errorf("error while deleting exhausted fee grant allowance: %s", err)
+			}
+			continue
+		}
+
+		err = m.UpdateFeeGrantAllowance(granter.Value, grantee.Value, tx.Height)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateFeeGrantAllowance updates the allowance given by the granter to the grantee
+// with the one present on the chain at the given height, so that its remaining spend limit is up to date
+func (m *Module) UpdateFeeGrantAllowance(granter string, grantee string, height int64) error {
+	allowance, err := m.source.GetAllowance(granter, grantee, height)
+	if err != nil {
+		return fmt.Errorf("error while getting fee grant allowance: %s", err)
+	}
+
+	// The allowance has been removed from the chain (e.g. it expired), nothing to update
+	if allowance == nil {
+		return nil
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		return fmt.Errorf("error while parsing granter address: %s", err)
+	}
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return fmt.Errorf("error while parsing grantee address: %s", err)
+	}
+
+	feeGrant, err := feegranttypes.NewGrant(granterAddr, granteeAddr, allowance)
+	if err != nil {
+		return fmt.Errorf("error while getting new grant allowance: %s", err)
+	}
+
+	return m.db.SaveFeeGrantAllowance(types.NewFeeGrant(feeGrant, height))
+}
@@ -0,0 +1,76 @@
+package local
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/forbole/juno/v5/node/local"
+
+	feegrantsource "github.com/forbole/callisto/v4/modules/feegrant/source"
+)
+
+var (
+	_ feegrantsource.Source = &Source{}
+)
+
+// Source implements feegrantsource.Source using a local node
+type Source struct {
+	*local.Source
+	q   feegranttypes.QueryServer
+	cdc codec.Codec
+}
+
+// NewSource returns a new Source instance
+func NewSource(source *local.Source, q feegranttypes.QueryServer, cdc codec.Codec) *Source {
+	return &Source{
+		Source: source,
+		q:      q,
+		cdc:    cdc,
+	}
+}
+
+// GetAllowance implements feegrantsource.Source
+func (s Source) GetAllowance(granter string, grantee string, height int64) (feegranttypes.FeeAllowanceI, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	// Query all the grantee allowances, since querying a missing one returns an untyped error
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := s.q.Allowances(sdk.WrapSDKContext(ctx), &feegranttypes.QueryAllowancesRequest{
+			Grantee: grantee,
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: 100, // Query 100 allowances at time
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error while getting allowances of %s: %s", grantee, err)
+		}
+
+		for _, grant := range res.Allowances {
+			if grant.Granter != granter {
+				continue
+			}
+
+			var allowance feegranttypes.FeeAllowanceI
+			err = s.cdc.UnpackAny(grant.Allowance, &allowance)
+			if err != nil {
+				return nil, fmt.Errorf("error while unpacking allowance: %s", err)
+			}
+
+			return allowance, nil
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+	}
+
+	return nil, nil
+}
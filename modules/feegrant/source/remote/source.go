@@ -0,0 +1,72 @@
+package remote
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/forbole/juno/v5/node/remote"
+
+	feegrantsource "github.com/forbole/callisto/v4/modules/feegrant/source"
+)
+
+var (
+	_ feegrantsource.Source = &Source{}
+)
+
+// Source implements feegrantsource.Source using a remote node
+type Source struct {
+	*remote.Source
+	feegrantClient feegranttypes.QueryClient
+	cdc            codec.Codec
+}
+
+// NewSource returns a new Source instance
+func NewSource(source *remote.Source, feegrantClient feegranttypes.QueryClient, cdc codec.Codec) *Source {
+	return &Source{
+		Source:         source,
+		feegrantClient: feegrantClient,
+		cdc:            cdc,
+	}
+}
+
+// GetAllowance implements feegrantsource.Source
+func (s Source) GetAllowance(granter string, grantee string, height int64) (feegranttypes.FeeAllowanceI, error) {
+	ctx := remote.GetHeightRequestContext(s.Ctx, height)
+
+	// Query all the grantee allowances, since querying a missing one returns an untyped error
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := s.feegrantClient.Allowances(ctx, &feegranttypes.QueryAllowancesRequest{
+			Grantee: grantee,
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: 100, // Query 100 allowances at time
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error while getting allowances of %s: %s", grantee, err)
+		}
+
+		for _, grant := range res.Allowances {
+			if grant.Granter != granter {
+				continue
+			}
+
+			var allowance feegranttypes.FeeAllowanceI
+			err = s.cdc.UnpackAny(grant.Allowance, &allowance)
+			if err != nil {
+				return nil, fmt.Errorf("error while unpacking allowance: %s", err)
+			}
+
+			return allowance, nil
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+	}
+
+	return nil, nil
+}
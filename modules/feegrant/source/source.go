@@ -0,0 +1,11 @@
+package source
+
+import (
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+type Source interface {
+	// GetAllowance returns the allowance given by the granter to the grantee at the given height.
+	// If no such allowance exists, nil is returned instead
+	GetAllowance(granter string, grantee string, height int64) (feegranttypes.FeeAllowanceI, error)
+}
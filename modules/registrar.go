@@ -17,6 +17,7 @@ import (
 
 	"github.com/forbole/callisto/v4/database"
 	"github.com/forbole/callisto/v4/modules/auth"
+	"github.com/forbole/callisto/v4/modules/authz"
 	"github.com/forbole/callisto/v4/modules/bank"
 	"github.com/forbole/callisto/v4/modules/consensus"
 	"github.com/forbole/callisto/v4/modules/distribution"
@@ -28,6 +29,7 @@ import (
 	"github.com/forbole/callisto/v4/modules/mint"
 	"github.com/forbole/callisto/v4/modules/modules"
 	"github.com/forbole/callisto/v4/modules/pricefeed"
+	"github.com/forbole/callisto/v4/modules/scoring"
 	"github.com/forbole/callisto/v4/modules/staking"
 	"github.com/forbole/callisto/v4/modules/upgrade"
 	juno "github.com/forbole/juno/v5/types"
@@ -73,17 +75,18 @@ func (r *Registrar) BuildModules(ctx registrar.Context) jmodules.Modules {
 		panic(err)
 	}
 
-	actionsModule := actions.NewModule(ctx.JunoConfig, ctx.EncodingConfig)
-	authModule := auth.NewModule(r.parser, cdc, db)
-	bankModule := bank.NewModule(r.parser, sources.BankSource, cdc, db)
+	actionsModule := actions.NewModule(ctx.JunoConfig, ctx.EncodingConfig, db)
+	authModule := auth.NewModule(sources.AuthSource, r.parser, cdc, db)
+	authzModule := authz.NewModule(cdc, db)
+	bankModule := bank.NewModule(ctx.JunoConfig, r.parser, sources.BankSource, cdc, db)
 	consensusModule := consensus.NewModule(db)
 	dailyRefetchModule := dailyrefetch.NewModule(ctx.Proxy, db)
 	distrModule := distribution.NewModule(sources.DistrSource, cdc, db)
-	feegrantModule := feegrant.NewModule(cdc, db)
+	feegrantModule := feegrant.NewModule(sources.FeegrantSource, cdc, db)
 	messagetypeModule := messagetype.NewModule(r.parser, cdc, db)
 	mintModule := mint.NewModule(sources.MintSource, cdc, db)
-	slashingModule := slashing.NewModule(sources.SlashingSource, cdc, db)
-	stakingModule := staking.NewModule(sources.StakingSource, cdc, db)
+	slashingModule := slashing.NewModule(ctx.JunoConfig, sources.SlashingSource, cdc, db)
+	stakingModule := staking.NewModule(ctx.JunoConfig, sources.StakingSource, ctx.Proxy, cdc, db)
 	govModule := gov.NewModule(sources.GovSource, distrModule, mintModule, slashingModule, stakingModule, cdc, db)
 	upgradeModule := upgrade.NewModule(db, stakingModule)
 
@@ -94,6 +97,7 @@ func (r *Registrar) BuildModules(ctx registrar.Context) jmodules.Modules {
 
 		actionsModule,
 		authModule,
+		authzModule,
 		bankModule,
 		consensusModule,
 		dailyRefetchModule,
@@ -104,6 +108,7 @@ func (r *Registrar) BuildModules(ctx registrar.Context) jmodules.Modules {
 		messagetypeModule,
 		modules.NewModule(ctx.JunoConfig.Chain, db),
 		pricefeed.NewModule(ctx.JunoConfig, cdc, db),
+		scoring.NewModule(ctx.JunoConfig, db),
 		slashingModule,
 		stakingModule,
 		upgradeModule,
@@ -0,0 +1,64 @@
+package scoring
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// Config contains the configuration about the scoring module
+type Config struct {
+	Weights types.ValidatorScoreWeights `yaml:"weights"`
+}
+
+// NewConfig returns a new Config instance
+func NewConfig(weights types.ValidatorScoreWeights) *Config {
+	return &Config{
+		Weights: weights,
+	}
+}
+
+// DefaultConfig returns the default configuration, which gives the same weight to all the rating criteria
+func DefaultConfig() *Config {
+	return NewConfig(types.NewValidatorScoreWeights(1, 1, 1, 1, 1))
+}
+
+// Validate checks that the configuration contains valid values
+func (c *Config) Validate() error {
+	for name, weight := range map[string]float64{
+		"self_delegation":   c.Weights.SelfDelegation,
+		"uptime":            c.Weights.Uptime,
+		"never_slashed":     c.Weights.NeverSlashed,
+		"gov_participation": c.Weights.GovParticipation,
+		"delegators":        c.Weights.Delegators,
+	} {
+		if weight < 0 {
+			return fmt.Errorf("invalid %s weight: %f", name, weight)
+		}
+	}
+
+	if c.Weights.Total() <= 0 {
+		return fmt.Errorf("at least one weight must be positive")
+	}
+
+	return nil
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"scoring"`
+	}
+	cfg := T{Config: DefaultConfig()}
+	err := yaml.Unmarshal(bz, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Config == nil {
+		return DefaultConfig(), nil
+	}
+
+	return cfg.Config, cfg.Config.Validate()
+}
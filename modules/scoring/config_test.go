@@ -0,0 +1,95 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/scoring"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    string
+		expected  *scoring.Config
+		shouldErr bool
+	}{
+		{
+			"all the weights are parsed",
+			`
+scoring:
+  weights:
+    self_delegation: 2
+    uptime: 3
+    never_slashed: 1
+    gov_participation: 0.5
+    delegators: 0
+`,
+			scoring.NewConfig(types.NewValidatorScoreWeights(2, 3, 1, 0.5, 0)),
+			false,
+		},
+		{
+			"missing weights keep their defaults",
+			`
+scoring:
+  weights:
+    uptime: 3
+    delegators: 0
+`,
+			scoring.NewConfig(types.NewValidatorScoreWeights(1, 3, 1, 1, 0)),
+			false,
+		},
+		{
+			"missing weights section returns the default config",
+			`
+scoring: {}
+`,
+			scoring.DefaultConfig(),
+			false,
+		},
+		{
+			"missing section returns the default config",
+			`chain: {}`,
+			scoring.DefaultConfig(),
+			false,
+		},
+		{
+			"negative weight returns error",
+			`
+scoring:
+  weights:
+    uptime: -1
+`,
+			nil,
+			true,
+		},
+		{
+			"all zero weights return error",
+			`
+scoring:
+  weights:
+    self_delegation: 0
+    uptime: 0
+    never_slashed: 0
+    gov_participation: 0
+    delegators: 0
+`,
+			nil,
+			true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := scoring.ParseConfig([]byte(test.config))
+			if test.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
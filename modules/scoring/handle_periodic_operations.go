@@ -0,0 +1,43 @@
+package scoring
+
+import (
+	"fmt"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+)
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "scoring").Msg("setting up periodic tasks")
+
+	// Setup a cron job to run every midnight
+	if _, err := scheduler.Every(1).Day().At("00:00").Do(func() {
+		utils.WatchMethod(m.UpdateValidatorsScores)
+	}); err != nil {
+		return fmt.Errorf("error while setting up scoring periodic operation: %s", err)
+	}
+
+	return nil
+}
+
+// UpdateValidatorsScores computes the score of all the validators at the latest stored block,
+// and saves it inside the database
+func (m *Module) UpdateValidatorsScores() error {
+	log.Debug().Str("module", "scoring").Str("operation", "validators scores").
+		Msg("updating validators scores")
+
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return err
+	}
+
+	// Skip if no block has been stored yet
+	if block.Height == 0 {
+		return nil
+	}
+
+	return m.db.SaveValidatorsScores(m.cfg.Weights, block.BlockTimestamp, block.Height)
+}
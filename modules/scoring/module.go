@@ -0,0 +1,42 @@
+package scoring
+
+import (
+	"github.com/forbole/juno/v5/modules"
+	"github.com/forbole/juno/v5/types/config"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+var (
+	_ modules.Module                   = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
+)
+
+// Module represents the module that computes the validators score based on their rating criteria
+type Module struct {
+	cfg *Config
+	db  *database.Db
+}
+
+// NewModule returns a new Module instance
+func NewModule(cfg config.Config, db *database.Db) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	scoringCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return &Module{
+		cfg: scoringCfg,
+		db:  db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "scoring"
+}
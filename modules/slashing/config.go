@@ -0,0 +1,56 @@
+package slashing
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config contains the configuration about the slashing module
+type Config struct {
+	// ReconciliationInterval is the number of blocks after which the signing infos of all the validators
+	// are refreshed. In between, only the signing infos affected by the events of each block are refreshed
+	ReconciliationInterval int64 `yaml:"reconciliation_interval"`
+}
+
+// NewConfig returns a new Config instance
+func NewConfig(reconciliationInterval int64) *Config {
+	return &Config{
+		ReconciliationInterval: reconciliationInterval,
+	}
+}
+
+// DefaultConfig returns the default configuration
+func DefaultConfig() *Config {
+	return NewConfig(100)
+}
+
+// Validate checks that the configuration contains valid values
+func (c *Config) Validate() error {
+	if c.ReconciliationInterval <= 0 {
+		return fmt.Errorf("invalid reconciliation interval: %d", c.ReconciliationInterval)
+	}
+	return nil
+}
+
+// IsReconciliationHeight tells whether all the signing infos should be refreshed at the given height
+func (c *Config) IsReconciliationHeight(height int64) bool {
+	return height%c.ReconciliationInterval == 0
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"slashing"`
+	}
+	cfg := T{Config: DefaultConfig()}
+	err := yaml.Unmarshal(bz, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Config == nil {
+		return DefaultConfig(), nil
+	}
+
+	return cfg.Config, cfg.Config.Validate()
+}
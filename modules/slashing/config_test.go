@@ -0,0 +1,72 @@
+package slashing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/slashing"
+)
+
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    string
+		expected  *slashing.Config
+		shouldErr bool
+	}{
+		{
+			"reconciliation interval is parsed",
+			`
+slashing:
+  reconciliation_interval: 50
+`,
+			slashing.NewConfig(50),
+			false,
+		},
+		{
+			"missing reconciliation interval keeps its default",
+			`
+slashing: {}
+`,
+			slashing.DefaultConfig(),
+			false,
+		},
+		{
+			"missing section returns the default config",
+			`chain: {}`,
+			slashing.DefaultConfig(),
+			false,
+		},
+		{
+			"negative reconciliation interval returns error",
+			`
+slashing:
+  reconciliation_interval: -1
+`,
+			nil,
+			true,
+		},
+		{
+			"zero reconciliation interval returns error",
+			`
+slashing:
+  reconciliation_interval: 0
+`,
+			nil,
+			true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := slashing.ParseConfig([]byte(test.config))
+			if test.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
@@ -2,34 +2,154 @@ package slashing
 
 import (
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	juno "github.com/forbole/juno/v5/types"
 
+	"github.com/forbole/callisto/v4/modules/utils"
+	"github.com/forbole/callisto/v4/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/rs/zerolog/log"
 )
 
 // HandleBlock implements BlockModule
 func (m *Module) HandleBlock(
-	block *tmctypes.ResultBlock, results *tmctypes.ResultBlockResults, _ []*juno.Tx, _ *tmctypes.ResultValidators,
+	block *tmctypes.ResultBlock, results *tmctypes.ResultBlockResults, txs []*juno.Tx, _ *tmctypes.ResultValidators,
 ) error {
 	// Update the signing infos
-	err := m.updateSigningInfo(block.Block.Height)
+	signingInfos, err := m.updateSigningInfo(block.Block.Height, txs, results.BeginBlockEvents)
 	if err != nil {
 		return fmt.Errorf("error while updating signing info: %s", err)
 	}
 
+	// Save the slashes applied during the begin block
+	err = m.SaveValidatorSlashes(block.Block.Height, results.BeginBlockEvents)
+	if err != nil {
+		return fmt.Errorf("error while saving validator slashes: %s", err)
+	}
+
+	// Save the validators jailed during the begin block
+	err = m.SaveValidatorJailEvents(block.Block.Height, block.Block.Time, results.BeginBlockEvents, signingInfos)
+	if err != nil {
+		return fmt.Errorf("error while saving validator jail events: %s", err)
+	}
+
 	return nil
 }
 
-// updateSigningInfo reads from the LCD the current staking pool and stores its value inside the database
-func (m *Module) updateSigningInfo(height int64) error {
+// updateSigningInfo reads from the node the signing infos changed at the given height and stores them
+// inside the database, returning them. At each reconciliation height the signing infos of all the validators
+// are stored instead
+func (m *Module) updateSigningInfo(
+	height int64, txs []*juno.Tx, beginBlockEvents []abci.Event,
+) ([]types.ValidatorSigningInfo, error) {
 	log.Debug().Str("module", "slashing").Int64("height", height).Msg("updating signing info")
 
-	signingInfos, err := m.getSigningInfos(height)
+	signingInfos, err := m.getUpdatedSigningInfos(height, txs, beginBlockEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(signingInfos) == 0 {
+		return nil, nil
+	}
+
+	return signingInfos, m.db.SaveValidatorsSigningInfos(signingInfos)
+}
+
+// getUpdatedSigningInfos returns the signing infos of all the validators when the given height is a reconciliation
+// height, and only the ones affected by the given transactions and begin block events otherwise
+func (m *Module) getUpdatedSigningInfos(
+	height int64, txs []*juno.Tx, beginBlockEvents []abci.Event,
+) ([]types.ValidatorSigningInfo, error) {
+	if m.cfg.IsReconciliationHeight(height) {
+		return m.getSigningInfos(height)
+	}
+
+	consAddrs, valOpers := GetAffectedSigningInfos(txs, beginBlockEvents)
+
+	affected := make(map[string]bool, len(consAddrs)+len(valOpers))
+	for _, consAddr := range consAddrs {
+		affected[consAddr] = true
+	}
+
+	for _, valOper := range valOpers {
+		consAddr, err := m.db.GetValidatorConsensusAddress(valOper)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting validator consensus address: %s", err)
+		}
+		affected[consAddr.String()] = true
+	}
+
+	signingInfos := make([]types.ValidatorSigningInfo, 0, len(affected))
+	for _, address := range utils.SortedKeys(affected) {
+		consAddr, err := sdk.ConsAddressFromBech32(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator consensus address %s: %s", address, err)
+		}
+
+		signingInfo, err := m.GetSigningInfo(height, consAddr)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting signing info of validator %s: %s", address, err)
+		}
+		signingInfos = append(signingInfos, signingInfo)
+	}
+
+	return signingInfos, nil
+}
+
+// SaveValidatorSlashes stores the validator slashes contained inside the given begin block events
+func (m *Module) SaveValidatorSlashes(height int64, events []abci.Event) error {
+	log.Debug().Str("module", "slashing").Int64("height", height).Msg("saving validator slashes")
+
+	slashes, err := ValidatorSlashesFromEvents(height, events)
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveValidatorSlashes(slashes)
+}
+
+// SaveValidatorJailEvents stores the jailing of the validators contained inside the given begin block events,
+// using the given signing infos to know until when they are jailed and whether they have been tombstoned
+func (m *Module) SaveValidatorJailEvents(
+	height int64, timestamp time.Time, events []abci.Event, signingInfos []types.ValidatorSigningInfo,
+) error {
+	log.Debug().Str("module", "slashing").Int64("height", height).Msg("saving validator jail events")
+
+	slashes, err := ValidatorSlashesFromEvents(height, events)
+	if err != nil {
+		return err
+	}
+
+	err = m.db.SaveValidatorJailEvents(ValidatorJailEventsFromSlashes(timestamp, slashes, signingInfos))
 	if err != nil {
 		return err
 	}
 
-	return m.db.SaveValidatorsSigningInfos(signingInfos)
+	// Validators slashed for double signing while already jailed are tombstoned without being jailed again
+	for _, slash := range slashes {
+		if slash.Jailed || slash.Reason != slashingtypes.AttributeValueDoubleSign {
+			continue
+		}
+
+		jailedUntil := evidencetypes.DoubleSignJailEndTime
+		for _, info := range signingInfos {
+			if info.ValidatorAddress == slash.ValidatorAddress {
+				jailedUntil = info.JailedUntil
+			}
+		}
+
+		err = m.db.SaveValidatorTombstone(slash.ValidatorAddress, jailedUntil, height)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,45 @@
+package slashing
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	juno "github.com/forbole/juno/v5/types"
+)
+
+// HandleMsgExec implements modules.AuthzMessageModule
+func (m *Module) HandleMsgExec(index int, _ *authz.MsgExec, _ int, executedMsg sdk.Msg, tx *juno.Tx) error {
+	return m.HandleMsg(index, executedMsg, tx)
+}
+
+// HandleMsg implements modules.MessageModule
+func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
+	if len(tx.Logs) == 0 {
+		return nil
+	}
+
+	switch cosmosMsg := msg.(type) {
+	case *slashingtypes.MsgUnjail:
+		return m.handleMsgUnjail(tx, cosmosMsg)
+	}
+
+	return nil
+}
+
+// handleMsgUnjail stores the unjailing of the validator that sent the given MsgUnjail
+func (m *Module) handleMsgUnjail(tx *juno.Tx, msg *slashingtypes.MsgUnjail) error {
+	consAddr, err := m.db.GetValidatorConsensusAddress(msg.ValidatorAddr)
+	if err != nil {
+		return fmt.Errorf("error while getting validator consensus address: %s", err)
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return fmt.Errorf("error while parsing tx timestamp: %s", err)
+	}
+
+	return m.db.SaveValidatorUnjail(consAddr.String(), tx.Height, timestamp)
+}
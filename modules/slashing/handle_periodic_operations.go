@@ -0,0 +1,88 @@
+package slashing
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+	"github.com/forbole/callisto/v4/types"
+)
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "slashing").Msg("setting up periodic tasks")
+
+	if _, err := scheduler.Every(5).Minutes().Do(func() {
+		utils.WatchMethod(func() error {
+			return m.UpdateValidatorsUptime(types.UptimePeriodSignedBlocksWindow, types.UptimePeriodHour)
+		})
+	}); err != nil {
+		return fmt.Errorf("error while setting up slashing periodic operation: %s", err)
+	}
+
+	if _, err := scheduler.Every(1).Hour().Do(func() {
+		utils.WatchMethod(func() error {
+			return m.UpdateValidatorsUptime(types.UptimePeriodDay, types.UptimePeriodWeek)
+		})
+	}); err != nil {
+		return fmt.Errorf("error while setting up slashing periodic operation: %s", err)
+	}
+
+	return nil
+}
+
+// UpdateValidatorsUptime computes the uptime of all the validators over the given periods,
+// and stores it inside the database
+func (m *Module) UpdateValidatorsUptime(periods ...types.UptimePeriod) error {
+	log.Trace().Str("module", "slashing").Str("operation", "uptime").
+		Msg("updating validators uptime")
+
+	block, err := m.db.GetLastSignedBlock()
+	if err != nil {
+		return err
+	}
+
+	// Skip if no commit has been stored yet
+	if block.Height == 0 {
+		return nil
+	}
+
+	for _, period := range periods {
+		startHeight, err := m.getUptimeStartHeight(period, block.Height, block.BlockTimestamp)
+		if err != nil {
+			return err
+		}
+
+		err = m.db.SaveValidatorsUptime(period, startHeight, block.Height)
+		if err != nil {
+			return fmt.Errorf("error while saving validators uptime for period %s: %s", period, err)
+		}
+	}
+
+	return nil
+}
+
+// getUptimeStartHeight returns the first height of the given period ending at the given height and timestamp
+func (m *Module) getUptimeStartHeight(period types.UptimePeriod, endHeight int64, endTime time.Time) (int64, error) {
+	if period == types.UptimePeriodSignedBlocksWindow {
+		params, err := m.source.GetParams(endHeight)
+		if err != nil {
+			return 0, fmt.Errorf("error while getting slashing params: %s", err)
+		}
+
+		startHeight := endHeight - params.SignedBlocksWindow + 1
+		if startHeight < 1 {
+			startHeight = 1
+		}
+		return startHeight, nil
+	}
+
+	startHeight, err := m.db.GetFirstBlockHeightAfter(endTime.Add(-period.GetDuration()))
+	if err != nil {
+		return 0, err
+	}
+	return startHeight, nil
+}
@@ -3,27 +3,43 @@ package slashing
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/forbole/juno/v5/modules"
+	"github.com/forbole/juno/v5/types/config"
 
 	"github.com/forbole/callisto/v4/database"
 	slashingsource "github.com/forbole/callisto/v4/modules/slashing/source"
 )
 
 var (
-	_ modules.Module        = &Module{}
-	_ modules.GenesisModule = &Module{}
-	_ modules.BlockModule   = &Module{}
+	_ modules.Module                   = &Module{}
+	_ modules.GenesisModule            = &Module{}
+	_ modules.BlockModule              = &Module{}
+	_ modules.MessageModule            = &Module{}
+	_ modules.AuthzMessageModule       = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
 )
 
 // Module represent x/slashing module
 type Module struct {
+	cfg    *Config
 	cdc    codec.Codec
 	db     *database.Db
 	source slashingsource.Source
 }
 
 // NewModule returns a new Module instance
-func NewModule(source slashingsource.Source, cdc codec.Codec, db *database.Db) *Module {
+func NewModule(cfg config.Config, source slashingsource.Source, cdc codec.Codec, db *database.Db) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	slashingCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
 	return &Module{
+		cfg:    slashingCfg,
 		cdc:    cdc,
 		db:     db,
 		source: source,
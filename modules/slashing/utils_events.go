@@ -0,0 +1,157 @@
+package slashing
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+	"github.com/forbole/callisto/v4/types"
+)
+
+// ValidatorSlashesFromEvents returns the validator slashes contained inside the given block events.
+// Slash events containing only the jailed attribute are emitted when a validator is jailed after being slashed
+// for double signing, and mark the previous slash of the same validator as jailed
+func ValidatorSlashesFromEvents(height int64, events []abci.Event) ([]types.ValidatorSlash, error) {
+	var slashes []types.ValidatorSlash
+	for _, event := range juno.FindEventsByType(events, slashingtypes.EventTypeSlash) {
+		jailedAttr, jailedErr := juno.FindAttributeByKey(event, slashingtypes.AttributeKeyJailed)
+
+		addressAttr, err := juno.FindAttributeByKey(event, slashingtypes.AttributeKeyAddress)
+		if err != nil {
+			if jailedErr != nil {
+				return nil, fmt.Errorf("invalid slash event without address")
+			}
+
+			for i := len(slashes) - 1; i >= 0; i-- {
+				if slashes[i].ValidatorAddress == jailedAttr.Value {
+					slashes[i].Jailed = true
+					break
+				}
+			}
+			continue
+		}
+
+		powerAttr, err := juno.FindAttributeByKey(event, slashingtypes.AttributeKeyPower)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting slash power: %s", err)
+		}
+
+		power, err := strconv.ParseInt(powerAttr.Value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid slash power %s: %s", powerAttr.Value, err)
+		}
+
+		reason := slashingtypes.AttributeValueUnspecified
+		if reasonAttr, err := juno.FindAttributeByKey(event, slashingtypes.AttributeKeyReason); err == nil {
+			reason = reasonAttr.Value
+		}
+
+		burnedTokens := sdk.ZeroInt()
+		if burnedAttr, err := juno.FindAttributeByKey(event, slashingtypes.AttributeKeyBurnedCoins); err == nil {
+			amount, ok := sdk.NewIntFromString(burnedAttr.Value)
+			if !ok {
+				return nil, fmt.Errorf("invalid slash burned coins: %s", burnedAttr.Value)
+			}
+			burnedTokens = amount
+		}
+
+		slashes = append(slashes, types.NewValidatorSlash(
+			addressAttr.Value,
+			power,
+			reason,
+			burnedTokens,
+			jailedErr == nil,
+			height,
+		))
+	}
+
+	return slashes, nil
+}
+
+// ValidatorJailEventsFromSlashes returns the jail events of the validators that have been jailed by the given slashes,
+// applied at the given time. The time until which each validator is jailed, and whether it has been tombstoned,
+// are read from the given signing infos when available
+func ValidatorJailEventsFromSlashes(
+	timestamp time.Time, slashes []types.ValidatorSlash, signingInfos []types.ValidatorSigningInfo,
+) []types.ValidatorJailEvent {
+	infos := make(map[string]types.ValidatorSigningInfo, len(signingInfos))
+	for _, info := range signingInfos {
+		infos[info.ValidatorAddress] = info
+	}
+
+	var events []types.ValidatorJailEvent
+	indexes := map[string]int{}
+	for _, slash := range slashes {
+		if !slash.Jailed {
+			continue
+		}
+
+		var jailedUntil *time.Time
+		tombstoned := slash.Reason == slashingtypes.AttributeValueDoubleSign
+		if info, ok := infos[slash.ValidatorAddress]; ok {
+			until := info.JailedUntil
+			jailedUntil = &until
+			tombstoned = info.Tombstoned
+		}
+
+		// A validator slashed for multiple reasons in the same block is jailed only once
+		if index, ok := indexes[slash.ValidatorAddress]; ok {
+			if slash.Reason == slashingtypes.AttributeValueDoubleSign {
+				events[index].Reason = slash.Reason
+			}
+			events[index].Tombstoned = events[index].Tombstoned || tombstoned
+			continue
+		}
+
+		indexes[slash.ValidatorAddress] = len(events)
+		events = append(events, types.NewValidatorJailEvent(
+			slash.ValidatorAddress,
+			slash.Reason,
+			slash.Height,
+			timestamp,
+			jailedUntil,
+			tombstoned,
+		))
+	}
+
+	return events
+}
+
+// GetAffectedSigningInfos returns the consensus addresses of the validators whose signing info has been changed
+// by the given begin block events, along with the operator addresses of the validators that have been unjailed
+// by the given transactions. Both lists are sorted and do not contain duplicates
+func GetAffectedSigningInfos(txs []*juno.Tx, beginBlockEvents []abci.Event) ([]string, []string) {
+	consAddrs := map[string]bool{}
+	for _, event := range beginBlockEvents {
+		if event.Type != slashingtypes.EventTypeSlash && event.Type != slashingtypes.EventTypeLiveness {
+			continue
+		}
+
+		for _, key := range []string{slashingtypes.AttributeKeyAddress, slashingtypes.AttributeKeyJailed} {
+			if attr, err := juno.FindAttributeByKey(event, key); err == nil {
+				consAddrs[attr.Value] = true
+			}
+		}
+	}
+
+	valOpers := map[string]bool{}
+	for _, tx := range txs {
+		if !tx.Successful() {
+			continue
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			if unjailMsg, ok := msg.(*slashingtypes.MsgUnjail); ok {
+				valOpers[unjailMsg.ValidatorAddr] = true
+			}
+		}
+	}
+
+	return utils.SortedKeys(consAddrs), utils.SortedKeys(valOpers)
+}
@@ -0,0 +1,160 @@
+package slashing_test
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/slashing"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func newSlashEvent(attributes ...abci.EventAttribute) abci.Event {
+	return abci.Event{Type: slashingtypes.EventTypeSlash, Attributes: attributes}
+}
+
+func TestValidatorSlashesFromEvents(t *testing.T) {
+	const valAddr = "cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl"
+
+	tests := []struct {
+		name      string
+		events    []abci.Event
+		expected  []types.ValidatorSlash
+		shouldErr bool
+	}{
+		{
+			"downtime slash returns properly",
+			[]abci.Event{
+				newSlashEvent(
+					abci.EventAttribute{Key: slashingtypes.AttributeKeyAddress, Value: valAddr},
+					abci.EventAttribute{Key: slashingtypes.AttributeKeyPower, Value: "100"},
+					abci.EventAttribute{Key: slashingtypes.AttributeKeyReason, Value: slashingtypes.AttributeValueMissingSignature},
+					abci.EventAttribute{Key: slashingtypes.AttributeKeyJailed, Value: valAddr},
+					abci.EventAttribute{Key: slashingtypes.AttributeKeyBurnedCoins, Value: "10"},
+				),
+			},
+			[]types.ValidatorSlash{
+				types.NewValidatorSlash(valAddr, 100, slashingtypes.AttributeValueMissingSignature, sdk.NewInt(10), true, 10),
+			},
+			false,
+		},
+		{
+			"double sign slash followed by jail returns properly",
+			[]abci.Event{
+				newSlashEvent(
+					abci.EventAttribute{Key: slashingtypes.AttributeKeyAddress, Value: valAddr},
+					abci.EventAttribute{Key: slashingtypes.AttributeKeyPower, Value: "100"},
+					abci.EventAttribute{Key: slashingtypes.AttributeKeyReason, Value: slashingtypes.AttributeValueDoubleSign},
+					abci.EventAttribute{Key: slashingtypes.AttributeKeyBurnedCoins, Value: "50"},
+				),
+				{Type: "other", Attributes: []abci.EventAttribute{{Key: "key", Value: "value"}}},
+				newSlashEvent(
+					abci.EventAttribute{Key: slashingtypes.AttributeKeyJailed, Value: valAddr},
+				),
+			},
+			[]types.ValidatorSlash{
+				types.NewValidatorSlash(valAddr, 100, slashingtypes.AttributeValueDoubleSign, sdk.NewInt(50), true, 10),
+			},
+			false,
+		},
+		{
+			"invalid power returns error",
+			[]abci.Event{
+				newSlashEvent(
+					abci.EventAttribute{Key: slashingtypes.AttributeKeyAddress, Value: valAddr},
+					abci.EventAttribute{Key: slashingtypes.AttributeKeyPower, Value: "invalid"},
+				),
+			},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			slashes, err := slashing.ValidatorSlashesFromEvents(10, tc.events)
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, slashes)
+		})
+	}
+}
+
+func TestGetAffectedSigningInfos(t *testing.T) {
+	const (
+		consAddr1 = "cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl"
+		consAddr2 = "cosmosvalcons1qq92t2l4jz5pt67tmts8ptl4p0jhr6utx5xa8y"
+		valOper   = "cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl"
+	)
+
+	unjailMsg, err := codectypes.NewAnyWithValue(&slashingtypes.MsgUnjail{ValidatorAddr: valOper})
+	require.NoError(t, err)
+
+	txs := []*juno.Tx{
+		{
+			Tx:         &tx.Tx{Body: &tx.TxBody{Messages: []*codectypes.Any{unjailMsg}}},
+			TxResponse: &sdk.TxResponse{Code: 0},
+		},
+	}
+
+	events := []abci.Event{
+		{
+			Type:       slashingtypes.EventTypeLiveness,
+			Attributes: []abci.EventAttribute{{Key: slashingtypes.AttributeKeyAddress, Value: consAddr2}},
+		},
+		newSlashEvent(
+			abci.EventAttribute{Key: slashingtypes.AttributeKeyAddress, Value: consAddr1},
+			abci.EventAttribute{Key: slashingtypes.AttributeKeyJailed, Value: consAddr1},
+		),
+		{Type: "other", Attributes: []abci.EventAttribute{{Key: slashingtypes.AttributeKeyAddress, Value: "ignored"}}},
+	}
+
+	consAddrs, valOpers := slashing.GetAffectedSigningInfos(txs, events)
+	require.Equal(t, []string{consAddr2, consAddr1}, consAddrs)
+	require.Equal(t, []string{valOper}, valOpers)
+
+	// Failed transactions are ignored
+	txs[0].Code = 5
+	_, valOpers = slashing.GetAffectedSigningInfos(txs, nil)
+	require.Empty(t, valOpers)
+}
+
+func TestValidatorJailEventsFromSlashes(t *testing.T) {
+	const (
+		valAddr1 = "cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl"
+		valAddr2 = "cosmosvalcons1qq92t2l4jz5pt67tmts8ptl4p0jhr6utx5xa8y"
+		valAddr3 = "cosmosvalcons1rtst6se0nfgjy362v33jt5d05crgdyhfvvvvay"
+	)
+
+	timestamp := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	jailedUntil := timestamp.Add(10 * time.Minute)
+
+	slashes := []types.ValidatorSlash{
+		types.NewValidatorSlash(valAddr1, 100, slashingtypes.AttributeValueMissingSignature, sdk.NewInt(10), true, 10),
+		types.NewValidatorSlash(valAddr2, 100, slashingtypes.AttributeValueDoubleSign, sdk.NewInt(50), true, 10),
+		types.NewValidatorSlash(valAddr2, 100, slashingtypes.AttributeValueMissingSignature, sdk.NewInt(10), true, 10),
+		// Slashes that do not jail the validator are ignored
+		types.NewValidatorSlash(valAddr3, 100, slashingtypes.AttributeValueDoubleSign, sdk.NewInt(50), false, 10),
+	}
+	signingInfos := []types.ValidatorSigningInfo{
+		types.NewValidatorSigningInfo(valAddr1, 1, 0, jailedUntil, false, 5, 10),
+	}
+
+	events := slashing.ValidatorJailEventsFromSlashes(timestamp, slashes, signingInfos)
+	require.Equal(t, []types.ValidatorJailEvent{
+		types.NewValidatorJailEvent(valAddr1, slashingtypes.AttributeValueMissingSignature, 10, timestamp, &jailedUntil, false),
+		types.NewValidatorJailEvent(valAddr2, slashingtypes.AttributeValueDoubleSign, 10, timestamp, nil, true),
+	}, events)
+}
@@ -0,0 +1,69 @@
+package slashing
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/require"
+
+	slashingsource "github.com/forbole/callisto/v4/modules/slashing/source"
+)
+
+// fakeSource is a slashing source that records the signing infos queries it receives
+type fakeSource struct {
+	slashingsource.Source
+
+	signingInfos []slashingtypes.ValidatorSigningInfo
+	calls        []string
+}
+
+func (s *fakeSource) GetSigningInfo(_ int64, consAddr sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, error) {
+	s.calls = append(s.calls, "GetSigningInfo "+consAddr.String())
+	return slashingtypes.ValidatorSigningInfo{Address: consAddr.String()}, nil
+}
+
+func (s *fakeSource) GetSigningInfos(_ int64) ([]slashingtypes.ValidatorSigningInfo, error) {
+	s.calls = append(s.calls, "GetSigningInfos")
+	return s.signingInfos, nil
+}
+
+func TestModule_getUpdatedSigningInfos(t *testing.T) {
+	const (
+		consAddr1 = "cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl"
+		consAddr2 = "cosmosvalcons1qq92t2l4jz5pt67tmts8ptl4p0jhr6utx5xa8y"
+	)
+
+	source := &fakeSource{signingInfos: []slashingtypes.ValidatorSigningInfo{
+		{Address: consAddr1},
+		{Address: consAddr2},
+	}}
+	m := &Module{cfg: NewConfig(10), source: source}
+
+	events := []abci.Event{{
+		Type:       slashingtypes.EventTypeLiveness,
+		Attributes: []abci.EventAttribute{{Key: slashingtypes.AttributeKeyAddress, Value: consAddr2}},
+	}}
+
+	// Reconciliation height refreshes all the signing infos
+	signingInfos, err := m.getUpdatedSigningInfos(10, nil, events)
+	require.NoError(t, err)
+	require.Len(t, signingInfos, 2)
+	require.Equal(t, []string{"GetSigningInfos"}, source.calls)
+
+	// Other heights refresh only the affected signing infos
+	source.calls = nil
+	signingInfos, err = m.getUpdatedSigningInfos(11, nil, events)
+	require.NoError(t, err)
+	require.Len(t, signingInfos, 1)
+	require.Equal(t, consAddr2, signingInfos[0].ValidatorAddress)
+	require.Equal(t, []string{"GetSigningInfo " + consAddr2}, source.calls)
+
+	// Blocks without events do not refresh any signing info
+	source.calls = nil
+	signingInfos, err = m.getUpdatedSigningInfos(12, nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, signingInfos)
+	require.Empty(t, source.calls)
+}
@@ -0,0 +1,111 @@
+package staking
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/forbole/callisto/v4/modules/staking/identity"
+)
+
+// Config contains the configuration about the staking module
+type Config struct {
+	// HistoryRetentionDays is the number of days for which the validators voting power, status, commission
+	// and delegation stats history is kept. A value of 0 keeps the whole history
+	HistoryRetentionDays int `yaml:"history_retention_days"`
+
+	// ReconciliationInterval is the number of blocks after which all the validators are refreshed.
+	// In between, only the validators affected by the events of each block are refreshed
+	ReconciliationInterval int64 `yaml:"reconciliation_interval"`
+
+	// AvatarResolvers contains the resolvers used to get the validators avatar URL from their identity.
+	// The resolvers are queried in the given order, until one of them returns an avatar
+	AvatarResolvers []identity.ResolverConfig `yaml:"avatar_resolvers"`
+
+	// AvatarCacheTTL is the duration after which the cached validators avatar URLs are resolved again
+	AvatarCacheTTL time.Duration `yaml:"avatar_cache_ttl"`
+
+	// VotingPowerTopN is the number of validators having the most voting power
+	// whose share is tracked inside the voting power distribution history
+	VotingPowerTopN int `yaml:"voting_power_top_n"`
+}
+
+// NewConfig returns a new Config instance
+func NewConfig(
+	historyRetentionDays int, reconciliationInterval int64,
+	avatarResolvers []identity.ResolverConfig, avatarCacheTTL time.Duration, votingPowerTopN int,
+) *Config {
+	return &Config{
+		HistoryRetentionDays:   historyRetentionDays,
+		ReconciliationInterval: reconciliationInterval,
+		AvatarResolvers:        avatarResolvers,
+		AvatarCacheTTL:         avatarCacheTTL,
+		VotingPowerTopN:        votingPowerTopN,
+	}
+}
+
+// DefaultConfig returns the default configuration
+func DefaultConfig() *Config {
+	return NewConfig(
+		0,
+		100,
+		[]identity.ResolverConfig{identity.NewResolverConfig(identity.ResolverTypeKeybase, "")},
+		24*time.Hour,
+		10,
+	)
+}
+
+// Validate checks that the configuration contains valid values
+func (c *Config) Validate() error {
+	if c.HistoryRetentionDays < 0 {
+		return fmt.Errorf("invalid history retention days: %d", c.HistoryRetentionDays)
+	}
+	if c.ReconciliationInterval <= 0 {
+		return fmt.Errorf("invalid reconciliation interval: %d", c.ReconciliationInterval)
+	}
+	for _, resolver := range c.AvatarResolvers {
+		err := resolver.Validate()
+		if err != nil {
+			return err
+		}
+	}
+	if c.AvatarCacheTTL <= 0 {
+		return fmt.Errorf("invalid avatar cache ttl: %s", c.AvatarCacheTTL)
+	}
+	if c.VotingPowerTopN <= 0 {
+		return fmt.Errorf("invalid voting power top n: %d", c.VotingPowerTopN)
+	}
+	return nil
+}
+
+// IsReconciliationHeight tells whether all the validators should be refreshed at the given height
+func (c *Config) IsReconciliationHeight(height int64) bool {
+	return height%c.ReconciliationInterval == 0
+}
+
+// GetHistoryRetentionCutoff returns the time before which the validators history should be removed,
+// and false if the whole history should be kept
+func (c *Config) GetHistoryRetentionCutoff(now time.Time) (time.Time, bool) {
+	if c.HistoryRetentionDays == 0 {
+		return time.Time{}, false
+	}
+	return now.Add(-time.Duration(c.HistoryRetentionDays) * 24 * time.Hour), true
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"staking"`
+	}
+	cfg := T{Config: DefaultConfig()}
+	err := yaml.Unmarshal(bz, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Config == nil {
+		return DefaultConfig(), nil
+	}
+
+	return cfg.Config, cfg.Config.Validate()
+}
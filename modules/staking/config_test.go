@@ -0,0 +1,170 @@
+package staking_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/staking"
+	"github.com/forbole/callisto/v4/modules/staking/identity"
+)
+
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    string
+		expected  *staking.Config
+		shouldErr bool
+	}{
+		{
+			"all the values are parsed",
+			`
+staking:
+  history_retention_days: 30
+  reconciliation_interval: 50
+  avatar_resolvers:
+    - type: github
+    - type: static
+      file: avatars.yaml
+  avatar_cache_ttl: 12h
+  voting_power_top_n: 5
+`,
+			staking.NewConfig(30, 50, []identity.ResolverConfig{
+				identity.NewResolverConfig(identity.ResolverTypeGitHub, ""),
+				identity.NewResolverConfig(identity.ResolverTypeStatic, "avatars.yaml"),
+			}, 12*time.Hour, 5),
+			false,
+		},
+		{
+			"missing values keep their defaults",
+			`
+staking:
+  reconciliation_interval: 50
+  avatar_cache_ttl: 1h
+`,
+			staking.NewConfig(
+				0,
+				50,
+				[]identity.ResolverConfig{identity.NewResolverConfig(identity.ResolverTypeKeybase, "")},
+				time.Hour,
+				10,
+			),
+			false,
+		},
+		{
+			"avatar resolvers replace the default ones",
+			`
+staking:
+  avatar_resolvers:
+    - type: github
+`,
+			staking.NewConfig(
+				0,
+				100,
+				[]identity.ResolverConfig{identity.NewResolverConfig(identity.ResolverTypeGitHub, "")},
+				24*time.Hour,
+				10,
+			),
+			false,
+		},
+		{
+			"missing section returns the default config",
+			`chain: {}`,
+			staking.DefaultConfig(),
+			false,
+		},
+		{
+			"empty section returns the default config",
+			`staking:`,
+			staking.DefaultConfig(),
+			false,
+		},
+		{
+			"negative history retention days return error",
+			`
+staking:
+  history_retention_days: -1
+`,
+			nil,
+			true,
+		},
+		{
+			"zero reconciliation interval returns error",
+			`
+staking:
+  reconciliation_interval: 0
+`,
+			nil,
+			true,
+		},
+		{
+			"static avatar resolver without file returns error",
+			`
+staking:
+  avatar_resolvers:
+    - type: static
+`,
+			nil,
+			true,
+		},
+		{
+			"unknown avatar resolver returns error",
+			`
+staking:
+  avatar_resolvers:
+    - type: unknown
+`,
+			nil,
+			true,
+		},
+		{
+			"zero avatar cache ttl returns error",
+			`
+staking:
+  avatar_cache_ttl: 0s
+`,
+			nil,
+			true,
+		},
+		{
+			"zero voting power top n returns error",
+			`
+staking:
+  voting_power_top_n: 0
+`,
+			nil,
+			true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := staking.ParseConfig([]byte(test.config))
+			if test.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
+
+func TestConfig_GetHistoryRetentionCutoff(t *testing.T) {
+	now := time.Date(2024, 3, 15, 13, 45, 12, 0, time.UTC)
+
+	_, ok := staking.DefaultConfig().GetHistoryRetentionCutoff(now)
+	require.False(t, ok)
+
+	cutoff, ok := staking.NewConfig(10, 100, nil, time.Hour, 10).GetHistoryRetentionCutoff(now)
+	require.True(t, ok)
+	require.Equal(t, time.Date(2024, 3, 5, 13, 45, 12, 0, time.UTC), cutoff)
+}
+
+func TestConfig_IsReconciliationHeight(t *testing.T) {
+	cfg := staking.NewConfig(0, 10, nil, time.Hour, 10)
+	require.True(t, cfg.IsReconciliationHeight(10))
+	require.True(t, cfg.IsReconciliationHeight(20))
+	require.False(t, cfg.IsReconciliationHeight(15))
+}
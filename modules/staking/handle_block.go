@@ -1,75 +1,124 @@
 package staking
 
 import (
-	"encoding/hex"
 	"fmt"
+	"time"
 
-	"github.com/forbole/callisto/v4/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	juno "github.com/forbole/juno/v5/types"
 
 	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
-	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/rs/zerolog/log"
 )
 
 // HandleBlock implements BlockModule
 func (m *Module) HandleBlock(
-	block *tmctypes.ResultBlock, res *tmctypes.ResultBlockResults, _ []*juno.Tx, vals *tmctypes.ResultValidators,
+	block *tmctypes.ResultBlock, res *tmctypes.ResultBlockResults, txs []*juno.Tx, vals *tmctypes.ResultValidators,
 ) error {
+	// Register the messages that are going to be handled, so that dirty validators are updated once all of them are
+	// and flush the ones of previous heights whose messages have not all been handled
+	stale := m.dirtyValidators.Register(block.Block.Height, block.Block.Time, countHandledMessages(txs))
+	for _, flush := range stale {
+		err := m.flushDirtyValidators(flush)
+		if err != nil {
+			log.Error().Str("module", "staking").Int64("height", flush.Height).Err(err).
+				Msg("error while flushing stale dirty validators")
+		}
+	}
+
+	// Update the evidences first, queueing them to be retried if they cannot be stored,
+	// so that they are not lost if any of the following operations fails
+	err := m.updateEvidences(block.Block.Height, block.Block.Evidence.Evidence)
+	if err != nil {
+		return fmt.Errorf("error while updating evidences: %s", err)
+	}
+
 	// Update the validators
-	_, err := m.updateValidators(block.Block.Height)
+	validators, err := m.updateValidators(block.Block.Height, txs, res)
 	if err != nil {
 		return fmt.Errorf("error while updating validators: %s", err)
 	}
 
-	// Updated the double sign evidences
-	go m.updateDoubleSignEvidence(block.Block.Height, block.Block.Evidence.Evidence)
+	// Refresh the delegations to the slashed validators, whose balances have been reduced by the slashes
+	err = m.refreshSlashedValidatorsDelegations(block.Block.Height, validators, res.BeginBlockEvents)
+	if err != nil {
+		return fmt.Errorf("error while refreshing slashed validators delegations: %s", err)
+	}
+
+	// Update the validator set changes
+	err = m.updateValidatorSetChanges(block.Block.Height, block.Block.Time, vals, validators, txs, res.BeginBlockEvents)
+	if err != nil {
+		return fmt.Errorf("error while updating validator set changes: %s", err)
+	}
+
+	// Remove the matured unbonding delegations and redelegations
+	err = m.removeMaturedEntries(block.Block.Height, block.Block.Time, res.EndBlockEvents)
+	if err != nil {
+		return fmt.Errorf("error while removing matured staking entries: %s", err)
+	}
 
 	return nil
 }
 
-// updateDoubleSignEvidence updates the double sign evidence of all validators
-func (m *Module) updateDoubleSignEvidence(height int64, evidenceList tmtypes.EvidenceList) {
+// removeMaturedEntries removes the unbonding delegation and redelegation entries
+// that have been completed by the chain at the end of the block
+func (m *Module) removeMaturedEntries(height int64, timestamp time.Time, events []abci.Event) error {
 	log.Debug().Str("module", "staking").Int64("height", height).
-		Msg("updating double sign evidence")
+		Msg("removing matured unbonding delegations and redelegations")
+
+	for _, event := range juno.FindEventsByType(events, stakingtypes.EventTypeCompleteUnbonding) {
+		delegator, err := juno.FindAttributeByKey(event, stakingtypes.AttributeKeyDelegator)
+		if err != nil {
+			return fmt.Errorf("error while getting unbonding delegator: %s", err)
+		}
+		validator, err := juno.FindAttributeByKey(event, stakingtypes.AttributeKeyValidator)
+		if err != nil {
+			return fmt.Errorf("error while getting unbonding validator: %s", err)
+		}
 
-	var evidences []types.DoubleSignEvidence
-	for _, ev := range evidenceList {
-		dve, ok := ev.(*tmtypes.DuplicateVoteEvidence)
-		if !ok {
-			continue
+		consAddr, err := m.db.GetValidatorConsensusAddress(validator.Value)
+		if err != nil {
+			return fmt.Errorf("error while getting validator consensus address: %s", err)
 		}
 
-		evidences = append(evidences, types.NewDoubleSignEvidence(
-			height,
-			types.NewDoubleSignVote(
-				int(dve.VoteA.Type),
-				dve.VoteA.Height,
-				dve.VoteA.Round,
-				dve.VoteA.BlockID.String(),
-				juno.ConvertValidatorAddressToBech32String(dve.VoteA.ValidatorAddress),
-				dve.VoteA.ValidatorIndex,
-				hex.EncodeToString(dve.VoteA.Signature),
-			),
-			types.NewDoubleSignVote(
-				int(dve.VoteB.Type),
-				dve.VoteB.Height,
-				dve.VoteB.Round,
-				dve.VoteB.BlockID.String(),
-				juno.ConvertValidatorAddressToBech32String(dve.VoteB.ValidatorAddress),
-				dve.VoteB.ValidatorIndex,
-				hex.EncodeToString(dve.VoteB.Signature),
-			),
-		),
-		)
+		err = m.db.DeleteMaturedUnbondingDelegationEntries(delegator.Value, consAddr.String(), timestamp)
+		if err != nil {
+			return err
+		}
 	}
 
-	err := m.db.SaveDoubleSignEvidences(evidences)
-	if err != nil {
-		log.Error().Str("module", "staking").Err(err).Int64("height", height).
-			Msg("error while saving double sign evidence")
-		return
+	for _, event := range juno.FindEventsByType(events, stakingtypes.EventTypeCompleteRedelegation) {
+		delegator, err := juno.FindAttributeByKey(event, stakingtypes.AttributeKeyDelegator)
+		if err != nil {
+			return fmt.Errorf("error while getting redelegation delegator: %s", err)
+		}
+		srcValidator, err := juno.FindAttributeByKey(event, stakingtypes.AttributeKeySrcValidator)
+		if err != nil {
+			return fmt.Errorf("error while getting redelegation source validator: %s", err)
+		}
+		dstValidator, err := juno.FindAttributeByKey(event, stakingtypes.AttributeKeyDstValidator)
+		if err != nil {
+			return fmt.Errorf("error while getting redelegation destination validator: %s", err)
+		}
+
+		srcConsAddr, err := m.db.GetValidatorConsensusAddress(srcValidator.Value)
+		if err != nil {
+			return fmt.Errorf("error while getting source validator consensus address: %s", err)
+		}
+		dstConsAddr, err := m.db.GetValidatorConsensusAddress(dstValidator.Value)
+		if err != nil {
+			return fmt.Errorf("error while getting destination validator consensus address: %s", err)
+		}
+
+		err = m.db.DeleteMaturedRedelegationEntries(
+			delegator.Value, srcConsAddr.String(), dstConsAddr.String(), timestamp,
+		)
+		if err != nil {
+			return err
+		}
 	}
 
+	return nil
 }
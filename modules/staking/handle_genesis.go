@@ -134,12 +134,18 @@ func (m *Module) saveValidatorsCommissions(height int64, validators stakingtypes
 	for _, account := range validators {
 		commissionRate := account.Commission.Rate
 		minSelfDelegation := account.MinSelfDelegation
-		err := m.db.SaveValidatorCommission(types.NewValidatorCommission(
+		commission := types.NewValidatorCommission(
 			account.OperatorAddress,
 			&commissionRate,
 			&minSelfDelegation,
 			height,
-		))
+		)
+		err := m.db.SaveValidatorCommission(commission)
+		if err != nil {
+			return err
+		}
+
+		err = m.db.SaveValidatorCommissionHistory(commission)
 		if err != nil {
 			return err
 		}
@@ -2,6 +2,7 @@ package staking
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -17,6 +18,30 @@ func (m *Module) HandleMsgExec(index int, _ *authz.MsgExec, _ int, executedMsg s
 
 // HandleMsg implements MessageModule
 func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
+	handleErr := m.handleMsg(msg, tx)
+
+	// Update the dirty validators once all the messages of the block have been handled
+	flush, ok := m.dirtyValidators.MessageHandled(tx.Height)
+	if ok && len(flush.ValOpers) > 0 {
+		if flush.Timestamp.IsZero() {
+			timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+			if err != nil {
+				return fmt.Errorf("error while parsing tx timestamp: %s", err)
+			}
+			flush.Timestamp = timestamp
+		}
+
+		err := m.flushDirtyValidators(flush)
+		if err != nil {
+			return err
+		}
+	}
+
+	return handleErr
+}
+
+// handleMsg handles the given message, marking as dirty the validators whose voting power has been changed
+func (m *Module) handleMsg(msg sdk.Msg, tx *juno.Tx) error {
 	if len(tx.Logs) == 0 {
 		return nil
 	}
@@ -28,17 +53,19 @@ func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
 	case *stakingtypes.MsgEditValidator:
 		return m.handleEditValidator(tx.Height, cosmosMsg)
 
-	// update validators statuses, voting power
-	// and proposals validators satatus snapshots
+	// update delegations, and mark the validators as dirty
 	// when there is a voting power change
 	case *stakingtypes.MsgDelegate:
-		return m.UpdateValidatorStatuses()
+		return m.handleDelegationChange(tx.Height, cosmosMsg.DelegatorAddress, cosmosMsg.ValidatorAddress)
 
 	case *stakingtypes.MsgBeginRedelegate:
-		return m.UpdateValidatorStatuses()
+		return m.handleMsgBeginRedelegate(tx.Height, cosmosMsg)
 
 	case *stakingtypes.MsgUndelegate:
-		return m.UpdateValidatorStatuses()
+		return m.handleUnbondingDelegationChange(tx.Height, cosmosMsg.DelegatorAddress, cosmosMsg.ValidatorAddress)
+
+	case *stakingtypes.MsgCancelUnbondingDelegation:
+		return m.handleUnbondingDelegationChange(tx.Height, cosmosMsg.DelegatorAddress, cosmosMsg.ValidatorAddress)
 
 	}
 
@@ -54,9 +81,57 @@ func (m *Module) handleMsgCreateValidator(height int64, msg *stakingtypes.MsgCre
 	if err != nil {
 		return fmt.Errorf("error while refreshing validator from MsgCreateValidator: %s", err)
 	}
+
+	// Store the self delegation made while creating the validator
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return fmt.Errorf("error while parsing validator address: %s", err)
+	}
+
+	err = m.RefreshDelegation(height, sdk.AccAddress(valAddr).String(), msg.ValidatorAddress)
+	if err != nil {
+		return fmt.Errorf("error while refreshing validator self delegation: %s", err)
+	}
+
+	m.dirtyValidators.Mark(height, msg.ValidatorAddress)
 	return nil
 }
 
+// handleDelegationChange refreshes the delegations made by the given delegator to the given validators,
+// and marks the validators as dirty so that their statuses are updated at the end of the block
+func (m *Module) handleDelegationChange(height int64, delegator string, valOpers ...string) error {
+	for _, valOper := range valOpers {
+		err := m.RefreshDelegation(height, delegator, valOper)
+		if err != nil {
+			return fmt.Errorf("error while refreshing delegation: %s", err)
+		}
+	}
+
+	m.dirtyValidators.Mark(height, valOpers...)
+	return nil
+}
+
+// handleMsgBeginRedelegate refreshes the delegations and the redelegation entries changed by a MsgBeginRedelegate
+func (m *Module) handleMsgBeginRedelegate(height int64, msg *stakingtypes.MsgBeginRedelegate) error {
+	err := m.RefreshRedelegation(height, msg.DelegatorAddress, msg.ValidatorSrcAddress, msg.ValidatorDstAddress)
+	if err != nil {
+		return fmt.Errorf("error while refreshing redelegation: %s", err)
+	}
+
+	return m.handleDelegationChange(height, msg.DelegatorAddress, msg.ValidatorSrcAddress, msg.ValidatorDstAddress)
+}
+
+// handleUnbondingDelegationChange refreshes the delegation and the unbonding delegation entries
+// of the given delegator from the given validator
+func (m *Module) handleUnbondingDelegationChange(height int64, delegator string, valOper string) error {
+	err := m.RefreshUnbondingDelegation(height, delegator, valOper)
+	if err != nil {
+		return fmt.Errorf("error while refreshing unbonding delegation: %s", err)
+	}
+
+	return m.handleDelegationChange(height, delegator, valOper)
+}
+
 // handleEditValidator handles MsgEditValidator utils, updating the validator info
 func (m *Module) handleEditValidator(height int64, msg *stakingtypes.MsgEditValidator) error {
 	err := m.RefreshValidatorInfos(height, msg.ValidatorAddress)
@@ -2,11 +2,13 @@ package staking
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/go-co-op/gocron"
 	"github.com/rs/zerolog/log"
 
 	"github.com/forbole/callisto/v4/modules/utils"
+	"github.com/forbole/callisto/v4/types"
 )
 
 // RegisterPeriodicOperations implements modules.PeriodicOperationsModule
@@ -27,6 +29,48 @@ func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 		return fmt.Errorf("error while setting up gov period operations: %s", err)
 	}
 
+	// Update the staking APR every hour
+	if _, err := scheduler.Every(1).Hour().Do(func() {
+		utils.WatchMethod(m.UpdateStakingAPR)
+	}); err != nil {
+		return fmt.Errorf("error while scheduling staking apr periodic operation: %s", err)
+	}
+
+	// Update the voting power distribution every hour
+	if _, err := scheduler.Every(1).Hour().Do(func() {
+		utils.WatchMethod(m.UpdateVotingPowerDistribution)
+	}); err != nil {
+		return fmt.Errorf("error while scheduling voting power distribution periodic operation: %s", err)
+	}
+
+	// Update the validators delegation stats every hour
+	if _, err := scheduler.Every(1).Hour().Do(func() {
+		utils.WatchMethod(m.UpdateValidatorsDelegationStats)
+	}); err != nil {
+		return fmt.Errorf("error while scheduling validators delegation stats periodic operation: %s", err)
+	}
+
+	// Retry to store the evidences that could not be stored every 5 mins
+	if _, err := scheduler.Every(5).Minutes().Do(func() {
+		utils.WatchMethod(m.RetryFailedEvidences)
+	}); err != nil {
+		return fmt.Errorf("error while scheduling evidences retry periodic operation: %s", err)
+	}
+
+	// Resolve the validators avatars that are not cached or expired every 5 mins
+	if _, err := scheduler.Every(5).Minutes().Do(func() {
+		utils.WatchMethod(m.RefreshValidatorsAvatars)
+	}); err != nil {
+		return fmt.Errorf("error while scheduling validators avatars periodic operation: %s", err)
+	}
+
+	// Remove the validators history older than the configured retention every day
+	if _, err := scheduler.Every(1).Day().At("00:00").Do(func() {
+		utils.WatchMethod(m.PruneValidatorsHistory)
+	}); err != nil {
+		return fmt.Errorf("error while scheduling validators history pruning: %s", err)
+	}
+
 	return nil
 }
 
@@ -53,3 +97,112 @@ func (m *Module) UpdateStakingPool() error {
 
 	return nil
 }
+
+// UpdateStakingAPR computes the current staking APR, along with the APR of each validator,
+// and stores them inside the database
+func (m *Module) UpdateStakingAPR() error {
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block height: %s", err)
+	}
+	log.Debug().Str("module", "staking").Int64("height", block.Height).
+		Msg("updating staking apr")
+
+	err = m.db.SaveStakingAPR(block.BlockTimestamp, block.Height)
+	if err != nil {
+		return fmt.Errorf("error while saving staking apr: %s", err)
+	}
+
+	return nil
+}
+
+// UpdateVotingPowerDistribution computes the current voting power distribution among the active validators
+// and stores it inside the database
+func (m *Module) UpdateVotingPowerDistribution() error {
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block height: %s", err)
+	}
+	log.Debug().Str("module", "staking").Int64("height", block.Height).
+		Msg("updating voting power distribution")
+
+	votingPowers, err := m.db.GetActiveValidatorsVotingPowers()
+	if err != nil {
+		return fmt.Errorf("error while getting active validators voting powers: %s", err)
+	}
+
+	distribution := types.NewVotingPowerDistribution(votingPowers, m.cfg.VotingPowerTopN, block.BlockTimestamp, block.Height)
+	err = m.db.SaveVotingPowerDistribution(distribution)
+	if err != nil {
+		return fmt.Errorf("error while saving voting power distribution: %s", err)
+	}
+
+	return nil
+}
+
+// UpdateValidatorsDelegationStats computes the self delegation and delegators count of all the validators
+// and stores them inside the database
+func (m *Module) UpdateValidatorsDelegationStats() error {
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block height: %s", err)
+	}
+	log.Debug().Str("module", "staking").Int64("height", block.Height).
+		Msg("updating validators delegation stats")
+
+	// Read the validators tokens from the chain so that the self delegations take into account the slashes
+	validators, err := m.source.GetValidatorsWithStatus(block.Height, "")
+	if err != nil {
+		return fmt.Errorf("error while getting validators: %s", err)
+	}
+
+	err = m.db.SaveValidatorsDelegationStats(getValidatorsTokens(validators), block.Height)
+	if err != nil {
+		return fmt.Errorf("error while saving validators delegation stats: %s", err)
+	}
+
+	return nil
+}
+
+// RefreshValidatorsAvatars resolves the avatars of the validators identities that have not been cached yet
+// or whose cache has expired, and stores them inside the database
+func (m *Module) RefreshValidatorsAvatars() error {
+	now := time.Now()
+	identities, err := m.db.GetAvatarIdentitiesToRefresh(now.Add(-m.cfg.AvatarCacheTTL))
+	if err != nil {
+		return fmt.Errorf("error while getting avatar identities to refresh: %s", err)
+	}
+
+	log.Debug().Str("module", "staking").Int("identities", len(identities)).
+		Msg("refreshing validators avatars")
+
+	for _, id := range identities {
+		url, resolver, err := m.avatarResolvers.GetAvatarURL(id)
+		if err != nil {
+			// Keep the cached avatar, so that the identity is resolved again at the next refresh
+			log.Error().Str("module", "staking").Err(err).Str("identity", id).
+				Msg("error while resolving validator avatar")
+			continue
+		}
+
+		err = m.db.SaveValidatorAvatar(types.NewValidatorAvatar(id, url, resolver, now))
+		if err != nil {
+			return fmt.Errorf("error while saving validator avatar: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// PruneValidatorsHistory removes the validators history that is older than the configured retention
+func (m *Module) PruneValidatorsHistory() error {
+	cutoff, enabled := m.cfg.GetHistoryRetentionCutoff(time.Now())
+	if !enabled {
+		return nil
+	}
+
+	log.Debug().Str("module", "staking").Time("cutoff", cutoff).
+		Msg("pruning validators history")
+
+	return m.db.PruneValidatorsHistory(cutoff)
+}
@@ -0,0 +1,73 @@
+package identity
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// GitHubAPIURL is the base URL of the GitHub APIs
+const GitHubAPIURL = "https://api.github.com"
+
+var (
+	_ AvatarResolver = &GitHubResolver{}
+
+	// gitHubHandleRegex matches the valid GitHub user names
+	gitHubHandleRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,37}[a-zA-Z0-9])?$`)
+)
+
+// GitHubResolver resolves the identities that represent a GitHub handle prefixed with "github:".
+// Identities without the prefix are ignored, as they might be Keybase IDs that are also valid GitHub handles
+type GitHubResolver struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewGitHubResolver returns a new GitHubResolver instance querying the GitHub APIs at the given URL
+func NewGitHubResolver(baseURL string) *GitHubResolver {
+	return &GitHubResolver{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Name implements AvatarResolver
+func (r *GitHubResolver) Name() string {
+	return ResolverTypeGitHub
+}
+
+// GetAvatarURL implements AvatarResolver
+func (r *GitHubResolver) GetAvatarURL(identity string) (string, error) {
+	handle, ok := strings.CutPrefix(identity, "github:")
+	if !ok || !gitHubHandleRegex.MatchString(handle) {
+		return "", nil
+	}
+
+	resp, err := r.client.Get(fmt.Sprintf("%s/users/%s", r.baseURL, handle))
+	if err != nil {
+		return "", fmt.Errorf("error while querying github APIs: %s", err)
+	}
+	defer resp.Body.Close()
+
+	// The user does not exist
+	if resp.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("response status not valid: %s", resp.Status)
+	}
+
+	var user struct {
+		AvatarURL string `json:"avatar_url"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&user)
+	if err != nil {
+		return "", fmt.Errorf("error while unmarshalling response body: %s", err)
+	}
+
+	return user.AvatarURL, nil
+}
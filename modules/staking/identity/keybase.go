@@ -0,0 +1,25 @@
+package identity
+
+import (
+	"github.com/forbole/callisto/v4/modules/staking/keybase"
+)
+
+var _ AvatarResolver = &KeybaseResolver{}
+
+// KeybaseResolver resolves the identities using the Keybase APIs
+type KeybaseResolver struct{}
+
+// NewKeybaseResolver returns a new KeybaseResolver instance
+func NewKeybaseResolver() *KeybaseResolver {
+	return &KeybaseResolver{}
+}
+
+// Name implements AvatarResolver
+func (r *KeybaseResolver) Name() string {
+	return ResolverTypeKeybase
+}
+
+// GetAvatarURL implements AvatarResolver
+func (r *KeybaseResolver) GetAvatarURL(identity string) (string, error) {
+	return keybase.GetAvatarURL(identity)
+}
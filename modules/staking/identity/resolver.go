@@ -0,0 +1,112 @@
+package identity
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	ResolverTypeKeybase = "keybase"
+	ResolverTypeGitHub  = "github"
+	ResolverTypeStatic  = "static"
+)
+
+// AvatarResolver allows to get the avatar URL of a validator from the identity set inside its description
+type AvatarResolver interface {
+	// Name returns the name of the resolver
+	Name() string
+
+	// GetAvatarURL returns the avatar URL associated with the given identity.
+	// If no avatar is associated with such identity, it returns an empty string instead
+	GetAvatarURL(identity string) (string, error)
+}
+
+// ResolverConfig contains the configuration of a single avatar resolver
+type ResolverConfig struct {
+	Type string `yaml:"type"`
+
+	// File is the path of the identities mapping file used by the static resolver
+	File string `yaml:"file,omitempty"`
+}
+
+// NewResolverConfig returns a new ResolverConfig instance
+func NewResolverConfig(resolverType string, file string) ResolverConfig {
+	return ResolverConfig{
+		Type: resolverType,
+		File: file,
+	}
+}
+
+// Validate checks that the configuration contains valid values
+func (c ResolverConfig) Validate() error {
+	switch c.Type {
+	case ResolverTypeKeybase, ResolverTypeGitHub:
+		return nil
+	case ResolverTypeStatic:
+		if strings.TrimSpace(c.File) == "" {
+			return fmt.Errorf("missing file of %s avatar resolver", c.Type)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid avatar resolver type: %s", c.Type)
+	}
+}
+
+// NewAvatarResolver returns the AvatarResolver described by the given configuration
+func NewAvatarResolver(cfg ResolverConfig) (AvatarResolver, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	switch cfg.Type {
+	case ResolverTypeKeybase:
+		return NewKeybaseResolver(), nil
+	case ResolverTypeGitHub:
+		return NewGitHubResolver(GitHubAPIURL), nil
+	default:
+		return NewStaticResolver(cfg.File)
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// AvatarResolvers represents a list of resolvers that are queried in order
+type AvatarResolvers []AvatarResolver
+
+// NewAvatarResolvers returns the AvatarResolvers described by the given configurations, in the same order
+func NewAvatarResolvers(configs []ResolverConfig) (AvatarResolvers, error) {
+	resolvers := make(AvatarResolvers, len(configs))
+	for i, cfg := range configs {
+		resolver, err := NewAvatarResolver(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating %s avatar resolver: %s", cfg.Type, err)
+		}
+		resolvers[i] = resolver
+	}
+	return resolvers, nil
+}
+
+// GetAvatarURL returns the first non empty avatar URL returned by the resolvers for the given identity,
+// along with the name of the resolver that returned it.
+// If no resolver finds an avatar, it returns an empty URL, unless one of them failed
+func (r AvatarResolvers) GetAvatarURL(identity string) (string, string, error) {
+	var errs []string
+	for _, resolver := range r {
+		url, err := resolver.GetAvatarURL(identity)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", resolver.Name(), err))
+			continue
+		}
+
+		if url != "" {
+			return url, resolver.Name(), nil
+		}
+	}
+
+	if len(errs) > 0 {
+		return "", "", fmt.Errorf("error while resolving avatar of identity %s: %s", identity, strings.Join(errs, "; "))
+	}
+
+	return "", "", nil
+}
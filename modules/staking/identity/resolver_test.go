@@ -0,0 +1,154 @@
+package identity_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/staking/identity"
+)
+
+// fakeResolver is an avatar resolver returning always the same result
+type fakeResolver struct {
+	name string
+	url  string
+	err  error
+}
+
+func (r fakeResolver) Name() string {
+	return r.name
+}
+
+func (r fakeResolver) GetAvatarURL(_ string) (string, error) {
+	return r.url, r.err
+}
+
+func TestAvatarResolvers_GetAvatarURL(t *testing.T) {
+	tests := []struct {
+		name             string
+		resolvers        identity.AvatarResolvers
+		expectedURL      string
+		expectedResolver string
+		shouldErr        bool
+	}{
+		{
+			"first non empty avatar is returned",
+			identity.AvatarResolvers{
+				fakeResolver{name: "first"},
+				fakeResolver{name: "second", url: "https://second.com/avatar.png"},
+				fakeResolver{name: "third", url: "https://third.com/avatar.png"},
+			},
+			"https://second.com/avatar.png",
+			"second",
+			false,
+		},
+		{
+			"failing resolvers are skipped when another one finds the avatar",
+			identity.AvatarResolvers{
+				fakeResolver{name: "first", err: fmt.Errorf("error")},
+				fakeResolver{name: "second", url: "https://second.com/avatar.png"},
+			},
+			"https://second.com/avatar.png",
+			"second",
+			false,
+		},
+		{
+			"no avatar found returns empty url",
+			identity.AvatarResolvers{fakeResolver{name: "first"}},
+			"",
+			"",
+			false,
+		},
+		{
+			"failing resolver without avatar found returns error",
+			identity.AvatarResolvers{
+				fakeResolver{name: "first"},
+				fakeResolver{name: "second", err: fmt.Errorf("error")},
+			},
+			"",
+			"",
+			true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			url, resolver, err := tc.resolvers.GetAvatarURL("identity")
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedURL, url)
+			require.Equal(t, tc.expectedResolver, resolver)
+		})
+	}
+}
+
+func TestGitHubResolver_GetAvatarURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/forbole":
+			_, _ = w.Write([]byte(`{"login":"forbole","avatar_url":"https://avatars.githubusercontent.com/u/1"}`))
+		case "/users/broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	resolver := identity.NewGitHubResolver(server.URL)
+
+	url, err := resolver.GetAvatarURL("github:forbole")
+	require.NoError(t, err)
+	require.Equal(t, "https://avatars.githubusercontent.com/u/1", url)
+
+	// Identities without the prefix should not be queried, as they might be Keybase IDs
+	url, err = resolver.GetAvatarURL("forbole")
+	require.NoError(t, err)
+	require.Empty(t, url)
+
+	_, err = resolver.GetAvatarURL("broken")
+	require.NoError(t, err)
+
+	url, err = resolver.GetAvatarURL("github:unknown")
+	require.NoError(t, err)
+	require.Empty(t, url)
+
+	url, err = resolver.GetAvatarURL("github:not a handle")
+	require.NoError(t, err)
+	require.Empty(t, url)
+
+	_, err = resolver.GetAvatarURL("github:broken")
+	require.Error(t, err)
+}
+
+func TestStaticResolver_GetAvatarURL(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "avatars.yaml")
+	err := os.WriteFile(file, []byte(`
+5CB5F8BD5D4C1F8E: https://example.com/forbole.png
+`), 0600)
+	require.NoError(t, err)
+
+	resolver, err := identity.NewStaticResolver(file)
+	require.NoError(t, err)
+
+	url, err := resolver.GetAvatarURL("5CB5F8BD5D4C1F8E")
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/forbole.png", url)
+
+	url, err = resolver.GetAvatarURL("unknown")
+	require.NoError(t, err)
+	require.Empty(t, url)
+
+	_, err = identity.NewStaticResolver(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.Error(t, err)
+}
@@ -0,0 +1,46 @@
+package identity
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+var _ AvatarResolver = &StaticResolver{}
+
+// StaticResolver resolves the identities using a static mapping between identities and avatar URLs
+type StaticResolver struct {
+	avatars map[string]string
+}
+
+// NewStaticResolver returns a new StaticResolver instance reading the mapping from the given YAML file,
+// which should contain the avatar URL associated with each identity:
+//
+//	identity: https://example.com/avatar.png
+func NewStaticResolver(file string) (*StaticResolver, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading avatars file: %s", err)
+	}
+
+	var avatars map[string]string
+	err = yaml.Unmarshal(bz, &avatars)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing avatars file: %s", err)
+	}
+
+	return &StaticResolver{
+		avatars: avatars,
+	}, nil
+}
+
+// Name implements AvatarResolver
+func (r *StaticResolver) Name() string {
+	return ResolverTypeStatic
+}
+
+// GetAvatarURL implements AvatarResolver
+func (r *StaticResolver) GetAvatarURL(identity string) (string, error) {
+	return r.avatars[identity], nil
+}
@@ -5,8 +5,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 )
 
+// httpClient is the client used to query the Keybase APIs
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
 // GetAvatarURL returns the avatar URL from the given identity.
 // If no identity is found, it returns an empty string instead.
 func GetAvatarURL(identity string) (string, error) {
@@ -44,7 +48,7 @@ func GetAvatarURL(identity string) (string, error) {
 // queryKeyBase queries the Keybase APIs for the given endpoint, and de-serializes
 // the response as a JSON object inside the given ptr
 func queryKeyBase(endpoint string, ptr interface{}) error {
-	resp, err := http.Get("https://keybase.io/_/api/1.0" + endpoint)
+	resp, err := httpClient.Get("https://keybase.io/_/api/1.0" + endpoint)
 	if err != nil {
 		return fmt.Errorf("error while querying keybase APIs: %s", err)
 	}
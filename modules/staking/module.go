@@ -1,10 +1,15 @@
 package staking
 
 import (
+	"sync/atomic"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/forbole/juno/v5/modules"
+	"github.com/forbole/juno/v5/node"
+	"github.com/forbole/juno/v5/types/config"
 
 	"github.com/forbole/callisto/v4/database"
+	"github.com/forbole/callisto/v4/modules/staking/identity"
 	stakingsource "github.com/forbole/callisto/v4/modules/staking/source"
 )
 
@@ -18,19 +23,51 @@ var (
 
 // Module represents the x/staking module
 type Module struct {
+	cfg    *Config
 	cdc    codec.Codec
 	db     *database.Db
 	source stakingsource.Source
+	node   node.Node
+
+	dirtyValidators *dirtyValidators
+	avatarResolvers identity.AvatarResolvers
+
+	// validatorsInfo is used to resolve the validators affected by each block
+	validatorsInfo validatorsInfoReader
+
+	// reconciled tells whether all the validators have been refreshed since the module has been started
+	reconciled atomic.Bool
 }
 
 // NewModule returns a new Module instance
 func NewModule(
-	source stakingsource.Source, cdc codec.Codec, db *database.Db,
+	cfg config.Config, source stakingsource.Source, node node.Node, cdc codec.Codec, db *database.Db,
 ) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	stakingCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	avatarResolvers, err := identity.NewAvatarResolvers(stakingCfg.AvatarResolvers)
+	if err != nil {
+		panic(err)
+	}
+
 	return &Module{
+		cfg:    stakingCfg,
 		cdc:    cdc,
 		db:     db,
 		source: source,
+		node:   node,
+
+		dirtyValidators: newDirtyValidators(),
+		avatarResolvers: avatarResolvers,
+		validatorsInfo:  db,
 	}
 }
 
@@ -7,6 +7,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/forbole/juno/v5/node/local"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	stakingsource "github.com/forbole/callisto/v4/modules/staking/source"
 )
@@ -44,6 +46,48 @@ func (s Source) GetValidator(height int64, valOper string) (stakingtypes.Validat
 	return res.Validator, nil
 }
 
+// GetDelegation implements stakingsource.Source
+func (s Source) GetDelegation(height int64, delegator string, validator string) (*stakingtypes.DelegationResponse, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	res, err := s.q.Delegation(
+		sdk.WrapSDKContext(ctx),
+		&stakingtypes.QueryDelegationRequest{DelegatorAddr: delegator, ValidatorAddr: validator},
+	)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while reading delegation: %s", err)
+	}
+
+	return res.DelegationResponse, nil
+}
+
+// GetUnbondingDelegation implements stakingsource.Source
+func (s Source) GetUnbondingDelegation(height int64, delegator string, validator string) (*stakingtypes.UnbondingDelegation, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	res, err := s.q.UnbondingDelegation(
+		sdk.WrapSDKContext(ctx),
+		&stakingtypes.QueryUnbondingDelegationRequest{DelegatorAddr: delegator, ValidatorAddr: validator},
+	)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while reading unbonding delegation: %s", err)
+	}
+
+	return &res.Unbond, nil
+}
+
 // GetDelegationsWithPagination implements stakingsource.Source
 func (s Source) GetDelegationsWithPagination(height int64, delegator string, pagination *query.PageRequest) (*stakingtypes.QueryDelegatorDelegationsResponse, error) {
 	ctx, err := s.LoadHeight(height)
@@ -6,6 +6,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/forbole/juno/v5/node/remote"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	stakingsource "github.com/forbole/callisto/v4/modules/staking/source"
 )
@@ -41,6 +43,38 @@ func (s Source) GetValidator(height int64, valOper string) (stakingtypes.Validat
 	return res.Validator, nil
 }
 
+// GetDelegation implements stakingsource.Source
+func (s Source) GetDelegation(height int64, delegator string, validator string) (*stakingtypes.DelegationResponse, error) {
+	res, err := s.stakingClient.Delegation(
+		remote.GetHeightRequestContext(s.Ctx, height),
+		&stakingtypes.QueryDelegationRequest{DelegatorAddr: delegator, ValidatorAddr: validator},
+	)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while getting delegation: %s", err)
+	}
+
+	return res.DelegationResponse, nil
+}
+
+// GetUnbondingDelegation implements stakingsource.Source
+func (s Source) GetUnbondingDelegation(height int64, delegator string, validator string) (*stakingtypes.UnbondingDelegation, error) {
+	res, err := s.stakingClient.UnbondingDelegation(
+		remote.GetHeightRequestContext(s.Ctx, height),
+		&stakingtypes.QueryUnbondingDelegationRequest{DelegatorAddr: delegator, ValidatorAddr: validator},
+	)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while getting unbonding delegation: %s", err)
+	}
+
+	return &res.Unbond, nil
+}
+
 // GetValidatorsWithStatus implements stakingsource.Source
 func (s Source) GetValidatorsWithStatus(height int64, status string) ([]stakingtypes.Validator, error) {
 	ctx := remote.GetHeightRequestContext(s.Ctx, height)
@@ -8,10 +8,16 @@ import (
 type Source interface {
 	GetValidator(height int64, valOper string) (stakingtypes.Validator, error)
 	GetValidatorsWithStatus(height int64, status string) ([]stakingtypes.Validator, error)
+	// GetDelegation returns the delegation made by the given delegator to the given validator.
+	// If no such delegation exists, nil is returned instead
+	GetDelegation(height int64, delegator string, validator string) (*stakingtypes.DelegationResponse, error)
 	GetDelegationsWithPagination(height int64, delegator string, pagination *query.PageRequest) (*stakingtypes.QueryDelegatorDelegationsResponse, error)
 	GetRedelegations(height int64, request *stakingtypes.QueryRedelegationsRequest) (*stakingtypes.QueryRedelegationsResponse, error)
 	GetPool(height int64) (stakingtypes.Pool, error)
 	GetParams(height int64) (stakingtypes.Params, error)
+	// GetUnbondingDelegation returns the unbonding delegation of the given delegator from the given validator.
+	// If no such unbonding delegation exists, nil is returned instead
+	GetUnbondingDelegation(height int64, delegator string, validator string) (*stakingtypes.UnbondingDelegation, error)
 	GetUnbondingDelegations(height int64, delegator string, pagination *query.PageRequest) (*stakingtypes.QueryDelegatorUnbondingDelegationsResponse, error)
 	GetValidatorDelegationsWithPagination(height int64, validator string, pagination *query.PageRequest) (*stakingtypes.QueryValidatorDelegationsResponse, error)
 	GetUnbondingDelegationsFromValidator(height int64, validator string, pagination *query.PageRequest) (*stakingtypes.QueryValidatorUnbondingDelegationsResponse, error)
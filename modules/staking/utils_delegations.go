@@ -0,0 +1,209 @@
+package staking
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// RefreshDelegation refreshes the delegation made by the given delegator to the validator
+// having the given operator address, reading it from the chain at the provided height
+func (m *Module) RefreshDelegation(height int64, delegator string, valOper string) error {
+	consAddr, err := m.db.GetValidatorConsensusAddress(valOper)
+	if err != nil {
+		return fmt.Errorf("error while getting validator consensus address: %s", err)
+	}
+
+	res, err := m.source.GetDelegation(height, delegator, valOper)
+	if err != nil {
+		return fmt.Errorf("error while getting delegation: %s", err)
+	}
+
+	// The delegator has undelegated all the tokens from the validator
+	if res == nil {
+		return m.db.DeleteDelegation(delegator, consAddr.String(), height)
+	}
+
+	return m.db.SaveDelegations([]types.Delegation{
+		types.NewDelegation(delegator, consAddr.String(), res.Delegation.Shares, res.Balance, height),
+	})
+}
+
+// RefreshAllDelegations refreshes the delegations made to all the validators at the given height
+func (m *Module) RefreshAllDelegations(height int64) error {
+	stakingValidators, validators, err := m.getValidators(height)
+	if err != nil {
+		return fmt.Errorf("error while getting validators: %s", err)
+	}
+
+	// Make sure all the validators are stored before saving their delegations
+	err = m.db.SaveValidatorsData(validators)
+	if err != nil {
+		return fmt.Errorf("error while saving validators: %s", err)
+	}
+
+	for index, validator := range stakingValidators {
+		err = m.refreshValidatorDelegations(height, validator, validators[index].GetConsAddr())
+		if err != nil {
+			return fmt.Errorf("error while refreshing validator delegations: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// refreshSlashedValidatorsDelegations refreshes the delegations made to the validators slashed by the given
+// block events, since slashing a validator reduces the balance of all its delegations.
+// The slashed validators are looked up among the given ones, which have been refreshed at the same height
+func (m *Module) refreshSlashedValidatorsDelegations(
+	height int64, validators []stakingtypes.Validator, events []abci.Event,
+) error {
+	slashed := GetSlashedValidators(events)
+	if len(slashed) == 0 {
+		return nil
+	}
+
+	isSlashed := make(map[string]bool, len(slashed))
+	for _, consAddr := range slashed {
+		isSlashed[consAddr] = true
+	}
+
+	for _, validator := range validators {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return fmt.Errorf("error while getting validator consensus address: %s", err)
+		}
+
+		if !isSlashed[consAddr.String()] {
+			continue
+		}
+
+		err = m.refreshValidatorDelegations(height, validator, consAddr.String())
+		if err != nil {
+			return fmt.Errorf("error while refreshing slashed validator delegations: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// refreshValidatorDelegations stores all the delegations made to the given validator at the given height,
+// removing the ones that no longer exist
+func (m *Module) refreshValidatorDelegations(height int64, validator stakingtypes.Validator, consAddr string) error {
+	log.Debug().Str("module", "staking").Int64("height", height).
+		Str("validator", validator.OperatorAddress).Msg("refreshing validator delegations")
+
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := m.source.GetValidatorDelegationsWithPagination(height, validator.OperatorAddress, &query.PageRequest{
+			Key:   nextKey,
+			Limit: 100, // Query 100 delegations at time
+		})
+		if err != nil {
+			return fmt.Errorf("error while getting validator delegations: %s", err)
+		}
+
+		delegations := make([]types.Delegation, len(res.DelegationResponses))
+		for index, response := range res.DelegationResponses {
+			delegations[index] = types.NewDelegation(
+				response.Delegation.DelegatorAddress,
+				consAddr,
+				response.Delegation.Shares,
+				response.Balance,
+				height,
+			)
+		}
+
+		err = m.db.SaveDelegations(delegations)
+		if err != nil {
+			return err
+		}
+
+		nextKey = res.Pagination.GetNextKey()
+		stop = len(nextKey) == 0
+	}
+
+	return m.db.DeleteValidatorDelegationsBefore(consAddr, height)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// RefreshUnbondingDelegation refreshes the unbonding delegation entries of the given delegator from the validator
+// having the given operator address, reading them from the chain at the provided height
+func (m *Module) RefreshUnbondingDelegation(height int64, delegator string, valOper string) error {
+	consAddr, err := m.db.GetValidatorConsensusAddress(valOper)
+	if err != nil {
+		return fmt.Errorf("error while getting validator consensus address: %s", err)
+	}
+
+	unbondingDelegation, err := m.source.GetUnbondingDelegation(height, delegator, valOper)
+	if err != nil {
+		return fmt.Errorf("error while getting unbonding delegation: %s", err)
+	}
+
+	var entries []types.UnbondingDelegationEntry
+	if unbondingDelegation != nil {
+		for _, entry := range unbondingDelegation.Entries {
+			entries = append(entries, types.NewUnbondingDelegationEntry(
+				delegator,
+				consAddr.String(),
+				entry.CreationHeight,
+				entry.CompletionTime,
+				entry.InitialBalance,
+				entry.Balance,
+				height,
+			))
+		}
+	}
+
+	return m.db.ReplaceUnbondingDelegationEntries(delegator, consAddr.String(), entries, height)
+}
+
+// RefreshRedelegation refreshes the redelegation entries of the given delegator between the validators having
+// the given operator addresses, reading them from the chain at the provided height
+func (m *Module) RefreshRedelegation(height int64, delegator string, srcValOper string, dstValOper string) error {
+	srcConsAddr, err := m.db.GetValidatorConsensusAddress(srcValOper)
+	if err != nil {
+		return fmt.Errorf("error while getting source validator consensus address: %s", err)
+	}
+
+	dstConsAddr, err := m.db.GetValidatorConsensusAddress(dstValOper)
+	if err != nil {
+		return fmt.Errorf("error while getting destination validator consensus address: %s", err)
+	}
+
+	res, err := m.source.GetRedelegations(height, &stakingtypes.QueryRedelegationsRequest{
+		DelegatorAddr:    delegator,
+		SrcValidatorAddr: srcValOper,
+		DstValidatorAddr: dstValOper,
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return fmt.Errorf("error while getting redelegations: %s", err)
+	}
+
+	var entries []types.RedelegationEntry
+	for _, redelegation := range res.GetRedelegationResponses() {
+		for _, entry := range redelegation.Entries {
+			entries = append(entries, types.NewRedelegationEntry(
+				delegator,
+				srcConsAddr.String(),
+				dstConsAddr.String(),
+				entry.RedelegationEntry.CreationHeight,
+				entry.RedelegationEntry.CompletionTime,
+				entry.RedelegationEntry.InitialBalance,
+				entry.Balance,
+				height,
+			))
+		}
+	}
+
+	return m.db.ReplaceRedelegationEntries(delegator, srcConsAddr.String(), dstConsAddr.String(), entries, height)
+}
@@ -0,0 +1,188 @@
+package staking
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+)
+
+const (
+	// dirtyHeightTimeout is the time after which a height whose messages have not all been handled is considered
+	// aborted, and its dirty validators are flushed when a later height is registered
+	dirtyHeightTimeout = time.Minute
+
+	// maxDirtyFlushAttempts is the maximum number of times the dirty validators of a height are flushed
+	maxDirtyFlushAttempts = 3
+)
+
+// dirtyValidators keeps track of the validators whose status has been changed by the messages of each height,
+// so that they can be updated only once after all the messages of such height have been handled
+type dirtyValidators struct {
+	mu      sync.Mutex
+	heights map[int64]*dirtyHeight
+	now     func() time.Time
+}
+
+// dirtyHeight contains the validators marked as dirty at a single height,
+// along with the number of messages of such height that still have to be handled
+type dirtyHeight struct {
+	pendingMsgs  int
+	timestamp    time.Time
+	registeredAt time.Time
+	attempts     int
+	validators   map[string]bool
+}
+
+// dirtyFlush contains the dirty validators of a single height that should be updated
+type dirtyFlush struct {
+	Height    int64
+	Timestamp time.Time
+	ValOpers  []string
+	attempts  int
+}
+
+// newDirtyValidators returns a new dirtyValidators instance
+func newDirtyValidators() *dirtyValidators {
+	return &dirtyValidators{
+		heights: map[int64]*dirtyHeight{},
+		now:     time.Now,
+	}
+}
+
+// Register sets the number of messages that are going to be handled for the given height.
+// It returns the dirty validators of the previous heights whose messages have not all been handled in time,
+// which happens when the export of their transactions has been aborted, so that they can be flushed anyway
+func (d *dirtyValidators) Register(height int64, timestamp time.Time, msgsCount int) []dirtyFlush {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+
+	var stale []dirtyFlush
+	for entryHeight, entry := range d.heights {
+		if entryHeight >= height || now.Sub(entry.registeredAt) < dirtyHeightTimeout {
+			continue
+		}
+
+		delete(d.heights, entryHeight)
+		if len(entry.validators) > 0 {
+			stale = append(stale, entry.flush(entryHeight))
+		}
+	}
+	sort.Slice(stale, func(i, j int) bool { return stale[i].Height < stale[j].Height })
+
+	// Keep the validators marked by a previous registration of the same height
+	validators := map[string]bool{}
+	if entry, ok := d.heights[height]; ok {
+		validators = entry.validators
+	}
+
+	if msgsCount == 0 && len(validators) == 0 {
+		delete(d.heights, height)
+		return stale
+	}
+
+	d.heights[height] = &dirtyHeight{
+		pendingMsgs:  msgsCount,
+		timestamp:    timestamp,
+		registeredAt: now,
+		validators:   validators,
+	}
+	return stale
+}
+
+// Mark marks the validators having the given operator addresses as dirty at the given height.
+// If the height has not been registered, the validators are returned as soon as the current message is handled
+func (d *dirtyValidators) Mark(height int64, valOpers ...string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entry, ok := d.heights[height]
+	if !ok {
+		entry = &dirtyHeight{pendingMsgs: 1, registeredAt: d.now(), validators: map[string]bool{}}
+		d.heights[height] = entry
+	}
+
+	for _, valOper := range valOpers {
+		entry.validators[valOper] = true
+	}
+}
+
+// MessageHandled records that one of the messages of the given height has been handled.
+// Once all the messages of such height have been handled, it returns the validators marked as dirty and true
+func (d *dirtyValidators) MessageHandled(height int64) (dirtyFlush, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entry, ok := d.heights[height]
+	if !ok {
+		return dirtyFlush{}, false
+	}
+
+	entry.pendingMsgs--
+	if entry.pendingMsgs > 0 {
+		return dirtyFlush{}, false
+	}
+
+	delete(d.heights, height)
+	return entry.flush(height), true
+}
+
+// Restore puts back the given dirty validators after they could not be updated, so that they are flushed again
+// when the next height is registered. It returns false if the maximum number of attempts has been reached
+func (d *dirtyValidators) Restore(flush dirtyFlush) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	attempts := flush.attempts + 1
+	if attempts >= maxDirtyFlushAttempts {
+		return false
+	}
+
+	entry, ok := d.heights[flush.Height]
+	if !ok {
+		// A zero registration time makes the entry stale right away
+		entry = &dirtyHeight{timestamp: flush.Timestamp, validators: map[string]bool{}}
+		d.heights[flush.Height] = entry
+	}
+
+	entry.attempts = attempts
+	for _, valOper := range flush.ValOpers {
+		entry.validators[valOper] = true
+	}
+	return true
+}
+
+// flush returns the dirty validators of the entry stored for the given height
+func (h *dirtyHeight) flush(height int64) dirtyFlush {
+	return dirtyFlush{
+		Height:    height,
+		Timestamp: h.timestamp,
+		ValOpers:  utils.SortedKeys(h.validators),
+		attempts:  h.attempts,
+	}
+}
+
+// countHandledMessages returns the number of times the messages handlers are going to be called
+// for the given transactions, including the messages executed through an authz MsgExec
+func countHandledMessages(txs []*juno.Tx) int {
+	var count int
+	for _, tx := range txs {
+		if tx.Tx == nil || tx.Body == nil {
+			continue
+		}
+
+		for _, msgAny := range tx.Body.Messages {
+			count++
+			if msgExec, ok := msgAny.GetCachedValue().(*authz.MsgExec); ok {
+				count += len(msgExec.Msgs)
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,124 @@
+package staking
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirtyValidators(t *testing.T) {
+	dirty := newDirtyValidators()
+	dirty.Register(10, time.Time{}, 3)
+
+	// The validators are returned only once all the messages have been handled
+	dirty.Mark(10, "validator2", "validator1")
+	_, ok := dirty.MessageHandled(10)
+	require.False(t, ok)
+
+	_, ok = dirty.MessageHandled(10)
+	require.False(t, ok)
+
+	dirty.Mark(10, "validator1")
+	flush, ok := dirty.MessageHandled(10)
+	require.True(t, ok)
+	require.Equal(t, []string{"validator1", "validator2"}, flush.ValOpers)
+
+	// Heights that have been flushed are not tracked anymore
+	_, ok = dirty.MessageHandled(10)
+	require.False(t, ok)
+
+	// Heights that have not been registered are flushed after each message
+	dirty.Mark(11, "validator3")
+	flush, ok = dirty.MessageHandled(11)
+	require.True(t, ok)
+	require.Equal(t, []string{"validator3"}, flush.ValOpers)
+}
+
+func TestDirtyValidators_AbortedHeight(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dirty := newDirtyValidators()
+	dirty.now = func() time.Time { return now }
+
+	// Only one of the messages of the height is handled
+	timestamp := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+	require.Empty(t, dirty.Register(10, timestamp, 3))
+	dirty.Mark(10, "validator1")
+	_, ok := dirty.MessageHandled(10)
+	require.False(t, ok)
+
+	// Heights that might still be handled are not flushed
+	require.Empty(t, dirty.Register(11, timestamp, 0))
+
+	// Heights that have not been completed in time are flushed once a later height is registered
+	now = now.Add(dirtyHeightTimeout)
+	require.Empty(t, dirty.Register(9, timestamp, 0))
+	stale := dirty.Register(12, timestamp, 0)
+	require.Len(t, stale, 1)
+	require.Equal(t, int64(10), stale[0].Height)
+	require.Equal(t, timestamp, stale[0].Timestamp)
+	require.Equal(t, []string{"validator1"}, stale[0].ValOpers)
+
+	// Flushed heights are not tracked anymore
+	require.Empty(t, dirty.Register(13, timestamp, 0))
+	_, ok = dirty.MessageHandled(10)
+	require.False(t, ok)
+}
+
+func TestModule_flushDirtyValidators(t *testing.T) {
+	source := &fakeSource{err: fmt.Errorf("node unavailable")}
+	m := &Module{source: source, dirtyValidators: newDirtyValidators()}
+
+	timestamp := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	m.dirtyValidators.Register(10, timestamp, 1)
+	m.dirtyValidators.Mark(10, "validator1")
+	flush, ok := m.dirtyValidators.MessageHandled(10)
+	require.True(t, ok)
+
+	// Failed flushes are restored and retried when the next height is registered
+	for attempt := 1; attempt < maxDirtyFlushAttempts; attempt++ {
+		require.Error(t, m.flushDirtyValidators(flush))
+
+		stale := m.dirtyValidators.Register(int64(10+attempt), timestamp, 0)
+		require.Len(t, stale, 1)
+		require.Equal(t, int64(10), stale[0].Height)
+		require.Equal(t, timestamp, stale[0].Timestamp)
+		require.Equal(t, []string{"validator1"}, stale[0].ValOpers)
+		flush = stale[0]
+	}
+
+	// The validators are dropped once the maximum number of attempts has been reached
+	require.Error(t, m.flushDirtyValidators(flush))
+	require.Empty(t, m.dirtyValidators.Register(20, timestamp, 0))
+}
+
+func TestCountHandledMessages(t *testing.T) {
+	newAny := func(msg sdk.Msg) *codectypes.Any {
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		return msgAny
+	}
+
+	msgExec := &authz.MsgExec{Msgs: []*codectypes.Any{
+		newAny(&stakingtypes.MsgDelegate{}),
+		newAny(&stakingtypes.MsgUndelegate{}),
+	}}
+
+	txs := []*juno.Tx{
+		{Tx: &tx.Tx{Body: &tx.TxBody{Messages: []*codectypes.Any{newAny(&stakingtypes.MsgDelegate{})}}}},
+		{Tx: &tx.Tx{Body: &tx.TxBody{Messages: []*codectypes.Any{
+			newAny(&stakingtypes.MsgDelegate{}),
+			newAny(msgExec),
+		}}}},
+	}
+
+	require.Equal(t, 5, countHandledMessages(txs))
+	require.Equal(t, 0, countHandledMessages(nil))
+}
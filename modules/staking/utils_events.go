@@ -0,0 +1,125 @@
+package staking
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+)
+
+// validatorEventsAttributes contains, for each event type, the attributes holding the address
+// of the validators affected by such event
+var validatorEventsAttributes = map[string][]string{
+	stakingtypes.EventTypeCreateValidator:           {stakingtypes.AttributeKeyValidator},
+	stakingtypes.EventTypeDelegate:                  {stakingtypes.AttributeKeyValidator},
+	stakingtypes.EventTypeUnbond:                    {stakingtypes.AttributeKeyValidator},
+	stakingtypes.EventTypeCancelUnbondingDelegation: {stakingtypes.AttributeKeyValidator},
+	stakingtypes.EventTypeRedelegate:                {stakingtypes.AttributeKeySrcValidator, stakingtypes.AttributeKeyDstValidator},
+	stakingtypes.EventTypeCompleteUnbonding:         {stakingtypes.AttributeKeyValidator},
+	stakingtypes.EventTypeCompleteRedelegation:      {stakingtypes.AttributeKeySrcValidator, stakingtypes.AttributeKeyDstValidator},
+	slashingtypes.EventTypeSlash:                    {slashingtypes.AttributeKeyAddress, slashingtypes.AttributeKeyJailed},
+}
+
+// GetAffectedValidators returns the operator addresses and the consensus addresses of the validators
+// whose data has been changed by the given transactions and block results.
+// Both lists are sorted and do not contain duplicates
+func GetAffectedValidators(txs []*juno.Tx, results *tmctypes.ResultBlockResults) ([]string, []string, error) {
+	valOpers := map[string]bool{}
+	consAddrs := map[string]bool{}
+
+	addAddresses := func(events []abci.Event) {
+		for _, event := range events {
+			for _, key := range validatorEventsAttributes[event.Type] {
+				attr, err := juno.FindAttributeByKey(event, key)
+				if err != nil {
+					continue
+				}
+
+				// Slashing events reference the validators by their consensus address
+				if event.Type == slashingtypes.EventTypeSlash {
+					consAddrs[attr.Value] = true
+				} else {
+					valOpers[attr.Value] = true
+				}
+			}
+		}
+	}
+
+	for _, tx := range txs {
+		if !tx.Successful() {
+			continue
+		}
+
+		addAddresses(tx.Events)
+
+		msgs, err := getExecutedMsgs(tx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Editing and unjailing a validator do not emit any event referencing its address
+		for _, msg := range msgs {
+			switch cosmosMsg := msg.(type) {
+			case *stakingtypes.MsgEditValidator:
+				valOpers[cosmosMsg.ValidatorAddress] = true
+			case *slashingtypes.MsgUnjail:
+				valOpers[cosmosMsg.ValidatorAddr] = true
+			}
+		}
+	}
+
+	addAddresses(results.BeginBlockEvents)
+	addAddresses(results.EndBlockEvents)
+
+	for _, update := range results.ValidatorUpdates {
+		pubKey, err := cryptocodec.FromTmProtoPublicKey(update.PubKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error while converting validator update public key: %s", err)
+		}
+		consAddrs[sdk.ConsAddress(pubKey.Address()).String()] = true
+	}
+
+	return utils.SortedKeys(valOpers), utils.SortedKeys(consAddrs), nil
+}
+
+// GetSlashedValidators returns the consensus addresses of the validators slashed
+// by the given block events. The list is sorted and does not contain duplicates
+func GetSlashedValidators(events []abci.Event) []string {
+	consAddrs := map[string]bool{}
+	for _, event := range juno.FindEventsByType(events, slashingtypes.EventTypeSlash) {
+		attr, err := juno.FindAttributeByKey(event, slashingtypes.AttributeKeyAddress)
+		if err != nil {
+			continue
+		}
+		consAddrs[attr.Value] = true
+	}
+	return utils.SortedKeys(consAddrs)
+}
+
+// getExecutedMsgs returns the messages of the given transaction,
+// replacing each authz MsgExec with the messages executed through it
+func getExecutedMsgs(tx *juno.Tx) ([]sdk.Msg, error) {
+	var msgs []sdk.Msg
+	for _, msg := range tx.GetMsgs() {
+		msgExec, ok := msg.(*authz.MsgExec)
+		if !ok {
+			msgs = append(msgs, msg)
+			continue
+		}
+
+		executedMsgs, err := msgExec.GetMessages()
+		if err != nil {
+			return nil, fmt.Errorf("error while getting authz executed messages: %s", err)
+		}
+		msgs = append(msgs, executedMsgs...)
+	}
+	return msgs, nil
+}
@@ -0,0 +1,117 @@
+package staking_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/staking"
+)
+
+func newTx(t *testing.T, code uint32, events []abci.Event, msgs ...sdk.Msg) *juno.Tx {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys[i] = msgAny
+	}
+
+	return &juno.Tx{
+		Tx:         &tx.Tx{Body: &tx.TxBody{Messages: anys}},
+		TxResponse: &sdk.TxResponse{Code: code, Events: events},
+	}
+}
+
+func newEvent(eventType string, attributes ...string) abci.Event {
+	event := abci.Event{Type: eventType}
+	for i := 0; i < len(attributes); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: attributes[i], Value: attributes[i+1]})
+	}
+	return event
+}
+
+func TestGetAffectedValidators(t *testing.T) {
+	const (
+		valOper1 = "cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl"
+		valOper2 = "cosmosvaloper1000ya26q2cmh399q4c5aaacd9lmmdqp90kw2jn"
+		valOper3 = "cosmosvaloper1qs8tnw2t8l6amtzvdemnnsq9dzk0ag0z52uzay"
+		valOper4 = "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+		valOper5 = "cosmosvaloper1cu7p2fdlmnt7da5ytw7yngw5sp6gx6rhhcdkpm"
+		valOper6 = "cosmosvaloper1clpqr4nrk4khgkxj78fcwwh6dl3uw4epsluffn"
+		consAddr = "cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl"
+	)
+
+	pubKey := ed25519.GenPrivKeyFromSecret([]byte("validator")).PubKey()
+	tmPubKey, err := cryptocodec.ToTmProtoPublicKey(pubKey)
+	require.NoError(t, err)
+
+	txs := []*juno.Tx{
+		newTx(t, 0, []abci.Event{
+			newEvent(stakingtypes.EventTypeDelegate, stakingtypes.AttributeKeyValidator, valOper1),
+			newEvent(stakingtypes.EventTypeRedelegate,
+				stakingtypes.AttributeKeySrcValidator, valOper1,
+				stakingtypes.AttributeKeyDstValidator, valOper2,
+			),
+		}),
+		newTx(t, 0, nil,
+			&stakingtypes.MsgEditValidator{ValidatorAddress: valOper3},
+			&slashingtypes.MsgUnjail{ValidatorAddr: valOper4},
+		),
+		newTx(t, 5, []abci.Event{
+			newEvent(stakingtypes.EventTypeUnbond, stakingtypes.AttributeKeyValidator, valOper6),
+		}),
+	}
+
+	results := &tmctypes.ResultBlockResults{
+		BeginBlockEvents: []abci.Event{
+			newEvent(slashingtypes.EventTypeSlash, slashingtypes.AttributeKeyJailed, consAddr),
+			newEvent(slashingtypes.EventTypeLiveness, slashingtypes.AttributeKeyAddress, "ignored"),
+		},
+		EndBlockEvents: []abci.Event{
+			newEvent(stakingtypes.EventTypeCompleteUnbonding, stakingtypes.AttributeKeyValidator, valOper5),
+		},
+		ValidatorUpdates: []abci.ValidatorUpdate{{PubKey: tmPubKey, Power: 10}},
+	}
+
+	valOpers, consAddrs, err := staking.GetAffectedValidators(txs, results)
+	require.NoError(t, err)
+	require.Equal(t, []string{valOper2, valOper5, valOper3, valOper1, valOper4}, valOpers)
+
+	require.ElementsMatch(t, []string{consAddr, sdk.ConsAddress(pubKey.Address()).String()}, consAddrs)
+}
+
+func TestGetSlashedValidators(t *testing.T) {
+	const (
+		consAddr1 = "cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl"
+		consAddr2 = "cosmosvalcons1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq"
+	)
+
+	events := []abci.Event{
+		newEvent(slashingtypes.EventTypeSlash,
+			slashingtypes.AttributeKeyAddress, consAddr1,
+			slashingtypes.AttributeKeyReason, slashingtypes.AttributeValueMissingSignature,
+		),
+		newEvent(slashingtypes.EventTypeSlash, slashingtypes.AttributeKeyJailed, consAddr1),
+		newEvent(slashingtypes.EventTypeSlash,
+			slashingtypes.AttributeKeyAddress, consAddr2,
+			slashingtypes.AttributeKeyReason, slashingtypes.AttributeValueDoubleSign,
+		),
+		newEvent(slashingtypes.EventTypeSlash,
+			slashingtypes.AttributeKeyAddress, consAddr1,
+			slashingtypes.AttributeKeyReason, slashingtypes.AttributeValueDoubleSign,
+		),
+		newEvent(slashingtypes.EventTypeLiveness, slashingtypes.AttributeKeyAddress, "ignored"),
+	}
+
+	require.Equal(t, []string{consAddr2, consAddr1}, staking.GetSlashedValidators(events))
+}
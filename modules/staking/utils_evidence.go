@@ -0,0 +1,214 @@
+package staking
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// maxEvidenceRetryAttempts is the maximum number of times the evidences of a block are stored before giving up,
+// leaving them inside the evidence retry queue so that they can be inspected
+const maxEvidenceRetryAttempts = 100
+
+// updateEvidences stores the double sign and light client attack evidences included inside the block.
+// If they cannot be stored, they are put inside the evidence retry queue so that they are not lost
+func (m *Module) updateEvidences(height int64, evidenceList tmtypes.EvidenceList) error {
+	if len(evidenceList) == 0 {
+		return nil
+	}
+
+	log.Debug().Str("module", "staking").Int64("height", height).
+		Msg("updating evidences")
+
+	saveErr := m.saveEvidences(height, evidenceList)
+	if saveErr == nil {
+		return nil
+	}
+
+	log.Error().Str("module", "staking").Err(saveErr).Int64("height", height).
+		Msg("error while saving evidences, queueing them to be retried")
+
+	bz, err := encodeEvidences(evidenceList)
+	if err != nil {
+		return fmt.Errorf("error while encoding evidences: %s", err)
+	}
+
+	err = m.db.SaveEvidenceRetry(height, bz, saveErr.Error(), time.Now())
+	if err != nil {
+		return fmt.Errorf("error while queueing evidences: %s", err)
+	}
+
+	return nil
+}
+
+// RetryFailedEvidences tries to store again the evidences that are inside the evidence retry queue,
+// removing them from the queue once they are stored
+func (m *Module) RetryFailedEvidences() error {
+	retries, err := m.db.GetEvidenceRetries(maxEvidenceRetryAttempts)
+	if err != nil {
+		return fmt.Errorf("error while getting evidence retries: %s", err)
+	}
+
+	for _, retry := range retries {
+		log.Debug().Str("module", "staking").Int64("height", retry.Height).Int("attempts", retry.Attempts).
+			Msg("retrying to save evidences")
+
+		evidenceList, saveErr := decodeEvidences(retry.Evidences)
+		if saveErr == nil {
+			saveErr = m.saveEvidences(retry.Height, evidenceList)
+		}
+
+		if saveErr != nil {
+			err = m.db.SaveEvidenceRetry(retry.Height, retry.Evidences, saveErr.Error(), time.Now())
+			if err != nil {
+				return fmt.Errorf("error while updating evidence retry: %s", err)
+			}
+
+			if retry.Attempts+1 >= maxEvidenceRetryAttempts {
+				log.Error().Str("module", "staking").Err(saveErr).Int64("height", retry.Height).
+					Msg("too many attempts while saving evidences, giving up")
+			}
+			continue
+		}
+
+		err = m.db.DeleteEvidenceRetry(retry.Height)
+		if err != nil {
+			return fmt.Errorf("error while deleting evidence retry: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// saveEvidences converts and stores the given evidences included inside the block at the given height
+func (m *Module) saveEvidences(height int64, evidenceList tmtypes.EvidenceList) error {
+	doubleSignEvidences, lightClientAttackEvidences, err := convertEvidences(height, evidenceList)
+	if err != nil {
+		return err
+	}
+
+	err = m.db.SaveDoubleSignEvidences(doubleSignEvidences)
+	if err != nil {
+		return fmt.Errorf("error while saving double sign evidences: %s", err)
+	}
+
+	err = m.db.SaveLightClientAttackEvidences(lightClientAttackEvidences)
+	if err != nil {
+		return fmt.Errorf("error while saving light client attack evidences: %s", err)
+	}
+
+	return nil
+}
+
+// encodeEvidences returns the protobuf encoding of the given evidences
+func encodeEvidences(evidenceList tmtypes.EvidenceList) ([]byte, error) {
+	data := tmtypes.EvidenceData{Evidence: evidenceList}
+	pb, err := data.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return pb.Marshal()
+}
+
+// decodeEvidences returns the evidences having the given protobuf encoding
+func decodeEvidences(bz []byte) (tmtypes.EvidenceList, error) {
+	var pb tmproto.EvidenceList
+	err := pb.Unmarshal(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	var data tmtypes.EvidenceData
+	err = data.FromProto(&pb)
+	if err != nil {
+		return nil, err
+	}
+	return data.Evidence, nil
+}
+
+// convertEvidences converts the given evidences included inside the block at the given height
+// into double sign and light client attack evidences
+func convertEvidences(
+	height int64, evidenceList tmtypes.EvidenceList,
+) ([]types.DoubleSignEvidence, []types.LightClientAttackEvidence, error) {
+	var doubleSignEvidences []types.DoubleSignEvidence
+	var lightClientAttackEvidences []types.LightClientAttackEvidence
+	for _, ev := range evidenceList {
+		switch evidence := ev.(type) {
+		case *tmtypes.DuplicateVoteEvidence:
+			doubleSignEvidences = append(doubleSignEvidences, convertDuplicateVoteEvidence(height, evidence))
+
+		case *tmtypes.LightClientAttackEvidence:
+			lightClientAttackEvidence, err := convertLightClientAttackEvidence(height, evidence)
+			if err != nil {
+				return nil, nil, err
+			}
+			lightClientAttackEvidences = append(lightClientAttackEvidences, lightClientAttackEvidence)
+
+		default:
+			log.Error().Str("module", "staking").Int64("height", height).
+				Str("type", fmt.Sprintf("%T", ev)).Msg("unsupported evidence type")
+		}
+	}
+
+	return doubleSignEvidences, lightClientAttackEvidences, nil
+}
+
+// convertDuplicateVoteEvidence converts the given tendermint evidence into a DoubleSignEvidence
+func convertDuplicateVoteEvidence(height int64, dve *tmtypes.DuplicateVoteEvidence) types.DoubleSignEvidence {
+	return types.NewDoubleSignEvidence(
+		height,
+		convertDoubleSignVote(dve.VoteA),
+		convertDoubleSignVote(dve.VoteB),
+	)
+}
+
+// convertDoubleSignVote converts the given tendermint vote into a DoubleSignVote
+func convertDoubleSignVote(vote *tmtypes.Vote) types.DoubleSignVote {
+	return types.NewDoubleSignVote(
+		int(vote.Type),
+		vote.Height,
+		vote.Round,
+		vote.BlockID.String(),
+		juno.ConvertValidatorAddressToBech32String(vote.ValidatorAddress),
+		vote.ValidatorIndex,
+		hex.EncodeToString(vote.Signature),
+	)
+}
+
+// convertLightClientAttackEvidence converts the given tendermint evidence into a LightClientAttackEvidence
+func convertLightClientAttackEvidence(
+	height int64, lca *tmtypes.LightClientAttackEvidence,
+) (types.LightClientAttackEvidence, error) {
+	byzantineValidators := make([]types.ByzantineValidator, len(lca.ByzantineValidators))
+	for index, validator := range lca.ByzantineValidators {
+		consPubKey, err := juno.ConvertValidatorPubKeyToBech32String(validator.PubKey)
+		if err != nil {
+			return types.LightClientAttackEvidence{}, fmt.Errorf("error while converting byzantine validator pubkey: %s", err)
+		}
+
+		byzantineValidators[index] = types.NewByzantineValidator(
+			juno.ConvertValidatorAddressToBech32String(validator.Address),
+			consPubKey,
+			validator.VotingPower,
+		)
+	}
+
+	return types.NewLightClientAttackEvidence(
+		fmt.Sprintf("%X", lca.Hash()),
+		lca.ConflictingBlock.Height,
+		lca.ConflictingBlock.Hash().String(),
+		lca.CommonHeight,
+		byzantineValidators,
+		lca.TotalVotingPower,
+		lca.Timestamp,
+		height,
+	), nil
+}
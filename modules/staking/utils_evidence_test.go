@@ -0,0 +1,133 @@
+package staking
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// newLightClientAttackEvidence returns a valid light client attack evidence whose conflicting block
+// at the given height has been signed by the given private validator
+func newLightClientAttackEvidence(
+	t *testing.T, pv tmtypes.PrivValidator, height int64, timestamp time.Time,
+) *tmtypes.LightClientAttackEvidence {
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+
+	validator := tmtypes.NewValidator(pubKey, 10)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+
+	header := &tmtypes.Header{
+		Version:            tmversion.Consensus{Block: version.BlockProtocol},
+		ChainID:            "test-chain",
+		Height:             height,
+		Time:               timestamp,
+		ValidatorsHash:     valSet.Hash(),
+		NextValidatorsHash: valSet.Hash(),
+		ProposerAddress:    validator.Address,
+	}
+	blockID := tmtypes.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: tmtypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+	}
+
+	voteSet := tmtypes.NewVoteSet(header.ChainID, height, 0, tmproto.PrecommitType, valSet)
+	commit, err := tmtypes.MakeCommit(blockID, height, 0, voteSet, []tmtypes.PrivValidator{pv}, timestamp)
+	require.NoError(t, err)
+
+	return &tmtypes.LightClientAttackEvidence{
+		ConflictingBlock: &tmtypes.LightBlock{
+			SignedHeader: &tmtypes.SignedHeader{Header: header, Commit: commit},
+			ValidatorSet: valSet,
+		},
+		CommonHeight:        height - 1,
+		ByzantineValidators: []*tmtypes.Validator{validator},
+		TotalVotingPower:    30,
+		Timestamp:           timestamp,
+	}
+}
+
+func TestConvertEvidences(t *testing.T) {
+	timestamp := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	dve, err := tmtypes.NewMockDuplicateVoteEvidence(8, timestamp, "test-chain")
+	require.NoError(t, err)
+
+	pv := tmtypes.NewMockPV()
+	lca := newLightClientAttackEvidence(t, pv, 9, timestamp)
+
+	doubleSignEvidences, lightClientAttackEvidences, err := convertEvidences(10, tmtypes.EvidenceList{dve, lca})
+	require.NoError(t, err)
+
+	require.Equal(t, []types.DoubleSignEvidence{
+		types.NewDoubleSignEvidence(
+			10,
+			types.NewDoubleSignVote(
+				int(tmproto.PrecommitType),
+				8,
+				0,
+				dve.VoteA.BlockID.String(),
+				juno.ConvertValidatorAddressToBech32String(dve.VoteA.ValidatorAddress),
+				0,
+				hex.EncodeToString(dve.VoteA.Signature),
+			),
+			types.NewDoubleSignVote(
+				int(tmproto.PrecommitType),
+				8,
+				0,
+				dve.VoteB.BlockID.String(),
+				juno.ConvertValidatorAddressToBech32String(dve.VoteB.ValidatorAddress),
+				0,
+				hex.EncodeToString(dve.VoteB.Signature),
+			),
+		),
+	}, doubleSignEvidences)
+
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	consPubKey, err := juno.ConvertValidatorPubKeyToBech32String(pubKey)
+	require.NoError(t, err)
+
+	require.Equal(t, []types.LightClientAttackEvidence{
+		types.NewLightClientAttackEvidence(
+			fmt.Sprintf("%X", lca.Hash()),
+			9,
+			lca.ConflictingBlock.Hash().String(),
+			8,
+			[]types.ByzantineValidator{
+				types.NewByzantineValidator(juno.ConvertValidatorAddressToBech32String(pubKey.Address()), consPubKey, 10),
+			},
+			30,
+			timestamp,
+			10,
+		),
+	}, lightClientAttackEvidences)
+}
+
+func TestEncodeEvidences(t *testing.T) {
+	timestamp := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	dve, err := tmtypes.NewMockDuplicateVoteEvidence(8, timestamp, "test-chain")
+	require.NoError(t, err)
+	lca := newLightClientAttackEvidence(t, tmtypes.NewMockPV(), 9, timestamp)
+
+	bz, err := encodeEvidences(tmtypes.EvidenceList{dve, lca})
+	require.NoError(t, err)
+
+	evidenceList, err := decodeEvidences(bz)
+	require.NoError(t, err)
+	require.Len(t, evidenceList, 2)
+	require.Equal(t, dve.Hash(), evidenceList[0].Hash())
+	require.Equal(t, lca.Hash(), evidenceList[1].Hash())
+}
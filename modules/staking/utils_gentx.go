@@ -7,7 +7,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
-	"github.com/forbole/callisto/v4/modules/staking/keybase"
 	"github.com/forbole/callisto/v4/types"
 )
 
@@ -19,10 +18,7 @@ func (m *Module) StoreValidatorsFromMsgCreateValidator(height int64, msg *stakin
 	if err != nil {
 		return fmt.Errorf("error while unpacking pub key: %s", err)
 	}
-	avatarURL, err := keybase.GetAvatarURL(msg.Description.Identity)
-	if err != nil {
-		return fmt.Errorf("error while getting Avatar URL: %s", err)
-	}
+	avatarURL := m.getCachedAvatarURL(msg.Description.Identity)
 
 	// Save the validators
 	err = m.db.SaveValidatorData(
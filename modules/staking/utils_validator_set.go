@@ -0,0 +1,201 @@
+package staking
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/slashing"
+	"github.com/forbole/callisto/v4/types"
+)
+
+// updateValidatorSetChanges computes the changes of the active validator set and of the validators jailing
+// that happened at the given height, and stores them inside the database.
+// The previous active set is read from the node at the previous height, and the jailing changes are derived from
+// the block events and messages, so that the changes do not depend on the order in which the blocks are processed
+func (m *Module) updateValidatorSetChanges(
+	height int64, timestamp time.Time, vals *tmctypes.ResultValidators, validators []stakingtypes.Validator,
+	txs []*juno.Tx, beginBlockEvents []abci.Event,
+) error {
+	log.Debug().Str("module", "staking").Int64("height", height).Msg("updating validator set changes")
+
+	var previousSet []string
+	if height > 1 {
+		previousVals, err := m.node.Validators(height - 1)
+		if err != nil {
+			return fmt.Errorf("error while getting validators of previous height: %s", err)
+		}
+
+		// Make sure the validators that left the set are stored before referencing them
+		err = m.saveTendermintValidators(previousVals.Validators)
+		if err != nil {
+			return fmt.Errorf("error while saving validators of previous height: %s", err)
+		}
+
+		previousSet = getConsensusAddresses(previousVals)
+	}
+
+	jailed := getJailedValidators(beginBlockEvents)
+	unjailed, err := getUnjailedValidators(txs, validators)
+	if err != nil {
+		return fmt.Errorf("error while getting unjailed validators: %s", err)
+	}
+
+	// Validators slashed for double signing are tombstoned by the evidence module
+	slashes, err := slashing.ValidatorSlashesFromEvents(height, beginBlockEvents)
+	if err != nil {
+		return fmt.Errorf("error while getting validator slashes: %s", err)
+	}
+
+	var tombstoned []string
+	for _, slash := range slashes {
+		if slash.Reason == slashingtypes.AttributeValueDoubleSign {
+			tombstoned = append(tombstoned, slash.ValidatorAddress)
+		}
+	}
+
+	changes := GetValidatorSetChanges(
+		previousSet, getConsensusAddresses(vals), jailed, unjailed, tombstoned, height, timestamp,
+	)
+	return m.db.SaveValidatorSetChanges(changes)
+}
+
+// saveTendermintValidators stores the given Tendermint validators inside the database
+func (m *Module) saveTendermintValidators(vals []*tmtypes.Validator) error {
+	validators := make([]*juno.Validator, len(vals))
+	for index, val := range vals {
+		consPubKey, err := juno.ConvertValidatorPubKeyToBech32String(val.PubKey)
+		if err != nil {
+			return fmt.Errorf("error while converting validator pubkey: %s", err)
+		}
+		validators[index] = juno.NewValidator(juno.ConvertValidatorAddressToBech32String(val.Address), consPubKey)
+	}
+	return m.db.SaveValidators(validators)
+}
+
+// getConsensusAddresses returns the consensus addresses of the given Tendermint validators
+func getConsensusAddresses(vals *tmctypes.ResultValidators) []string {
+	addresses := make([]string, len(vals.Validators))
+	for i, val := range vals.Validators {
+		addresses[i] = sdk.ConsAddress(val.Address).String()
+	}
+	return addresses
+}
+
+// getJailedValidators returns the consensus addresses of the validators jailed by the slashing module,
+// which are referenced by the slash events emitted at the beginning of the block
+func getJailedValidators(events []abci.Event) []string {
+	var jailed []string
+	for _, event := range juno.FindEventsByType(events, slashingtypes.EventTypeSlash) {
+		attr, err := juno.FindAttributeByKey(event, slashingtypes.AttributeKeyJailed)
+		if err != nil {
+			continue
+		}
+		jailed = append(jailed, attr.Value)
+	}
+	return jailed
+}
+
+// getUnjailedValidators returns the consensus addresses of the validators unjailed by the successful
+// transactions of the block, resolving them from the given validators refreshed at the same height
+func getUnjailedValidators(txs []*juno.Tx, validators []stakingtypes.Validator) ([]string, error) {
+	var valOpers []string
+	for _, tx := range txs {
+		if !tx.Successful() {
+			continue
+		}
+
+		msgs, err := getExecutedMsgs(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, msg := range msgs {
+			if msgUnjail, ok := msg.(*slashingtypes.MsgUnjail); ok {
+				valOpers = append(valOpers, msgUnjail.ValidatorAddr)
+			}
+		}
+	}
+
+	if len(valOpers) == 0 {
+		return nil, nil
+	}
+
+	consAddrs := make(map[string]string, len(validators))
+	for _, validator := range validators {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return nil, fmt.Errorf("error while getting validator consensus address: %s", err)
+		}
+		consAddrs[validator.OperatorAddress] = consAddr.String()
+	}
+
+	var unjailed []string
+	for _, valOper := range valOpers {
+		consAddr, ok := consAddrs[valOper]
+		if !ok {
+			return nil, fmt.Errorf("unjailed validator %s has not been refreshed", valOper)
+		}
+		unjailed = append(unjailed, consAddr)
+	}
+	return unjailed, nil
+}
+
+// GetValidatorSetChanges returns the changes between the given previous active validator set and the current one,
+// along with the jailing changes of the given jailed, unjailed and tombstoned validators
+func GetValidatorSetChanges(
+	previousSet []string, activeSet []string, jailed []string, unjailed []string, tombstoned []string,
+	height int64, timestamp time.Time,
+) []types.ValidatorSetChange {
+	var changes []types.ValidatorSetChange
+
+	previous := make(map[string]bool, len(previousSet))
+	for _, address := range previousSet {
+		previous[address] = true
+	}
+
+	active := make(map[string]bool, len(activeSet))
+	for _, address := range activeSet {
+		active[address] = true
+		if !previous[address] {
+			changes = append(changes, types.NewValidatorSetChange(address, types.ValidatorSetChangeJoined, height, timestamp))
+		}
+	}
+
+	for _, address := range previousSet {
+		if !active[address] {
+			changes = append(changes, types.NewValidatorSetChange(address, types.ValidatorSetChangeLeft, height, timestamp))
+		}
+	}
+
+	for _, address := range jailed {
+		changes = append(changes, types.NewValidatorSetChange(address, types.ValidatorSetChangeJailed, height, timestamp))
+	}
+
+	for _, address := range unjailed {
+		changes = append(changes, types.NewValidatorSetChange(address, types.ValidatorSetChangeUnjailed, height, timestamp))
+	}
+
+	for _, address := range tombstoned {
+		changes = append(changes, types.NewValidatorSetChange(address, types.ValidatorSetChangeTombstoned, height, timestamp))
+	}
+
+	// Sort the changes to have a deterministic order
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].ValidatorAddress != changes[j].ValidatorAddress {
+			return changes[i].ValidatorAddress < changes[j].ValidatorAddress
+		}
+		return changes[i].Type < changes[j].Type
+	})
+
+	return changes
+}
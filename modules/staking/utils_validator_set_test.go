@@ -0,0 +1,46 @@
+package staking_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/staking"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func TestGetValidatorSetChanges(t *testing.T) {
+	timestamp := time.Date(2024, 3, 15, 13, 45, 12, 0, time.UTC)
+
+	changes := staking.GetValidatorSetChanges(
+		[]string{"validator1", "validator2"},
+		[]string{"validator1", "validator3", "validator4"},
+		[]string{"validator2"},
+		[]string{"validator3"},
+		[]string{"validator2"},
+		10,
+		timestamp,
+	)
+
+	require.Equal(t, []types.ValidatorSetChange{
+		types.NewValidatorSetChange("validator2", types.ValidatorSetChangeJailed, 10, timestamp),
+		types.NewValidatorSetChange("validator2", types.ValidatorSetChangeLeft, 10, timestamp),
+		types.NewValidatorSetChange("validator2", types.ValidatorSetChangeTombstoned, 10, timestamp),
+		types.NewValidatorSetChange("validator3", types.ValidatorSetChangeJoined, 10, timestamp),
+		types.NewValidatorSetChange("validator3", types.ValidatorSetChangeUnjailed, 10, timestamp),
+		types.NewValidatorSetChange("validator4", types.ValidatorSetChangeJoined, 10, timestamp),
+	}, changes)
+
+	// No changes should be returned when the set is the same
+	changes = staking.GetValidatorSetChanges(
+		[]string{"validator1"},
+		[]string{"validator1"},
+		nil,
+		nil,
+		nil,
+		11,
+		timestamp,
+	)
+	require.Empty(t, changes)
+}
@@ -2,10 +2,13 @@ package staking
 
 import (
 	"fmt"
+	"time"
 
-	"github.com/forbole/callisto/v4/modules/staking/keybase"
+	"github.com/forbole/callisto/v4/modules/utils"
 	"github.com/forbole/callisto/v4/types"
 
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	juno "github.com/forbole/juno/v5/types"
 	"github.com/rs/zerolog/log"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -55,8 +58,8 @@ func (m *Module) convertValidator(height int64, validator stakingtypes.Validator
 	), nil
 }
 
-// convertValidatorDescription returns a new types.ValidatorDescription object by fetching the avatar URL
-// using the Keybase APIs
+// convertValidatorDescription returns a new types.ValidatorDescription object using the cached avatar URL.
+// Identities whose avatar has not been cached yet are resolved later by the avatars refresh job
 func (m *Module) convertValidatorDescription(
 	height int64, opAddr string, description stakingtypes.Description,
 ) types.ValidatorDescription {
@@ -65,16 +68,28 @@ func (m *Module) convertValidatorDescription(
 	if description.Identity == stakingtypes.DoNotModifyDesc {
 		avatarURL = stakingtypes.DoNotModifyDesc
 	} else {
-		url, err := keybase.GetAvatarURL(description.Identity)
-		if err != nil {
-			url = ""
-		}
-		avatarURL = url
+		avatarURL = m.getCachedAvatarURL(description.Identity)
 	}
 
 	return types.NewValidatorDescription(opAddr, description, avatarURL, height)
 }
 
+// getCachedAvatarURL returns the cached avatar URL of the given identity, or an empty string if it is not cached
+func (m *Module) getCachedAvatarURL(identity string) string {
+	if identity == "" {
+		return ""
+	}
+
+	url, _, err := m.db.GetCachedAvatarURL(identity)
+	if err != nil {
+		log.Error().Str("module", "staking").Err(err).Str("identity", identity).
+			Msg("error while getting cached avatar url")
+		return ""
+	}
+
+	return url
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // RefreshAllValidatorInfos refreshes the info of all the validators at the given height
@@ -123,12 +138,18 @@ func (m *Module) RefreshValidatorInfos(height int64, valOper string) error {
 	}
 
 	// Save the commission
-	return m.db.SaveValidatorCommission(types.NewValidatorCommission(
+	commission := types.NewValidatorCommission(
 		stakingValidator.OperatorAddress,
 		&stakingValidator.Commission.Rate,
 		&stakingValidator.MinSelfDelegation,
 		height,
-	))
+	)
+	err = m.db.SaveValidatorCommission(commission)
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveValidatorCommissionHistory(commission)
 }
 
 // GetValidatorsWithStatus returns the list of all the validators having the given status at the given height
@@ -156,14 +177,28 @@ func (m *Module) getValidators(height int64) ([]stakingtypes.Validator, []types.
 	return m.GetValidatorsWithStatus(height, "")
 }
 
-// updateValidators updates the list of validators that are present at the given height
-func (m *Module) updateValidators(height int64) ([]stakingtypes.Validator, error) {
+// updateValidators refreshes the validators that have been changed at the given height, along with
+// their statuses and voting powers, and returns them.
+// At each reconciliation height all the validators are refreshed instead
+func (m *Module) updateValidators(
+	height int64, txs []*juno.Tx, results *tmctypes.ResultBlockResults,
+) ([]stakingtypes.Validator, error) {
 	log.Debug().Str("module", "staking").Int64("height", height).
 		Msg("updating validators")
 
-	vals, validators, err := m.getValidators(height)
+	vals, err := m.getUpdatedValidators(height, txs, results)
 	if err != nil {
-		return nil, fmt.Errorf("error while getting validator: %s", err)
+		return nil, err
+	}
+
+	validators := make([]types.Validator, len(vals))
+	for index, val := range vals {
+		validator, err := m.convertValidator(height, val)
+		if err != nil {
+			return nil, fmt.Errorf("error while converting validator: %s", err)
+		}
+
+		validators[index] = validator
 	}
 
 	err = m.db.SaveValidatorsData(validators)
@@ -171,7 +206,141 @@ func (m *Module) updateValidators(height int64) ([]stakingtypes.Validator, error
 		return nil, err
 	}
 
-	return vals, err
+	err = m.updateValidatorStatusAndVP(height, vals)
+	if err != nil {
+		return nil, fmt.Errorf("error while updating validators status and voting power: %s", err)
+	}
+
+	return vals, nil
+}
+
+// validatorsInfoReader allows to read the validators info stored inside the database
+type validatorsInfoReader interface {
+	HasValidatorsInfo() (bool, error)
+	FindValidatorOperatorAddress(consAddr string) (string, bool, error)
+}
+
+// isReconciliationNeeded tells whether all the validators should be refreshed at the given height.
+// Besides the reconciliation heights, this happens for the first height processed
+// and as long as no validator info has been stored
+func (m *Module) isReconciliationNeeded(height int64) (bool, error) {
+	if m.cfg.IsReconciliationHeight(height) || !m.reconciled.Load() {
+		return true, nil
+	}
+
+	hasInfo, err := m.validatorsInfo.HasValidatorsInfo()
+	if err != nil {
+		return false, fmt.Errorf("error while checking validators info existence: %s", err)
+	}
+
+	return !hasInfo, nil
+}
+
+// getUpdatedValidators returns all the validators when a reconciliation is needed at the given height,
+// and only the validators affected by the given transactions and block results otherwise
+func (m *Module) getUpdatedValidators(
+	height int64, txs []*juno.Tx, results *tmctypes.ResultBlockResults,
+) ([]stakingtypes.Validator, error) {
+	reconcile, err := m.isReconciliationNeeded(height)
+	if err != nil {
+		return nil, err
+	}
+
+	if reconcile {
+		validators, err := m.source.GetValidatorsWithStatus(height, "")
+		if err != nil {
+			return nil, fmt.Errorf("error while getting validators: %s", err)
+		}
+		m.reconciled.Store(true)
+		return validators, nil
+	}
+
+	valOpers, consAddrs, err := GetAffectedValidators(txs, results)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting affected validators: %s", err)
+	}
+
+	affected := make(map[string]bool, len(valOpers)+len(consAddrs))
+	for _, valOper := range valOpers {
+		affected[valOper] = true
+	}
+
+	var unresolved []string
+	for _, consAddr := range consAddrs {
+		valOper, found, err := m.validatorsInfo.FindValidatorOperatorAddress(consAddr)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting validator operator address: %s", err)
+		}
+		if !found {
+			unresolved = append(unresolved, consAddr)
+			continue
+		}
+		affected[valOper] = true
+	}
+
+	validators := make([]stakingtypes.Validator, 0, len(affected))
+	for _, valOper := range utils.SortedKeys(affected) {
+		validator, err := m.source.GetValidator(height, valOper)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting validator %s: %s", valOper, err)
+		}
+		validators = append(validators, validator)
+	}
+
+	return m.resolveValidatorsByConsAddr(height, validators, unresolved)
+}
+
+// resolveValidatorsByConsAddr adds to the given validators the ones having the given consensus addresses,
+// reading them from the source. This is used for the validators whose info has not been stored yet,
+// either because they have been created in this block or because their creation has not been processed yet
+func (m *Module) resolveValidatorsByConsAddr(
+	height int64, validators []stakingtypes.Validator, consAddrs []string,
+) ([]stakingtypes.Validator, error) {
+	if len(consAddrs) == 0 {
+		return validators, nil
+	}
+
+	missing := make(map[string]bool, len(consAddrs))
+	for _, consAddr := range consAddrs {
+		missing[consAddr] = true
+	}
+
+	// Validators created in this block are already referenced by their operator address
+	for _, validator := range validators {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return nil, fmt.Errorf("error while getting validator consensus address: %s", err)
+		}
+		delete(missing, consAddr.String())
+	}
+
+	if len(missing) == 0 {
+		return validators, nil
+	}
+
+	allValidators, err := m.source.GetValidatorsWithStatus(height, "")
+	if err != nil {
+		return nil, fmt.Errorf("error while getting validators: %s", err)
+	}
+
+	for _, validator := range allValidators {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return nil, fmt.Errorf("error while getting validator consensus address: %s", err)
+		}
+
+		if missing[consAddr.String()] {
+			validators = append(validators, validator)
+			delete(missing, consAddr.String())
+		}
+	}
+
+	for _, consAddr := range utils.SortedKeys(missing) {
+		log.Debug().Str("module", "staking").Int64("height", height).Str("validator", consAddr).
+			Msg("validator not found, skipping it")
+	}
+
+	return validators, nil
 }
 
 // --------------------------------------------------------------------------------------------------------------------
@@ -221,17 +390,79 @@ func (m *Module) UpdateValidatorStatuses() error {
 		return fmt.Errorf("error while updating validators status and voting power: %s", err)
 	}
 
+	// update validator status snapshots for all active proposals
+	return m.updateProposalsValidatorStatusSnapshots(block.Height, block.BlockTimestamp, validators)
+}
+
+// flushDirtyValidators updates the given dirty validators,
+// restoring them so that they are updated later if an error occurs
+func (m *Module) flushDirtyValidators(flush dirtyFlush) error {
+	err := m.updateDirtyValidators(flush.Height, flush.Timestamp, flush.ValOpers)
+	if err == nil {
+		return nil
+	}
+
+	if !m.dirtyValidators.Restore(flush) {
+		log.Error().Str("module", "staking").Int64("height", flush.Height).Strs("validators", flush.ValOpers).
+			Msg("too many attempts while updating dirty validators, skipping them")
+	}
+
+	return fmt.Errorf("error while updating dirty validators: %s", err)
+}
+
+// updateDirtyValidators updates the status, voting power and proposals validator status snapshots
+// of the validators having the given operator addresses
+func (m *Module) updateDirtyValidators(height int64, timestamp time.Time, valOpers []string) error {
+	log.Debug().Str("module", "staking").Int64("height", height).Int("validators", len(valOpers)).
+		Msg("updating dirty validators")
+
+	validators := make([]stakingtypes.Validator, len(valOpers))
+	for index, valOper := range valOpers {
+		validator, err := m.source.GetValidator(height, valOper)
+		if err != nil {
+			return fmt.Errorf("error while getting validator %s: %s", valOper, err)
+		}
+		validators[index] = validator
+	}
+
+	err := m.updateValidatorStatusAndVP(height, validators)
+	if err != nil {
+		return fmt.Errorf("error while updating validators status and voting power: %s", err)
+	}
+
+	err = m.db.SaveValidatorsDelegationStats(getValidatorsTokens(validators), height)
+	if err != nil {
+		return fmt.Errorf("error while saving validators delegation stats: %s", err)
+	}
+
+	return m.updateProposalsValidatorStatusSnapshots(height, timestamp, validators)
+}
+
+// getValidatorsTokens returns the tokens and delegator shares of the given validators
+func getValidatorsTokens(validators []stakingtypes.Validator) []types.ValidatorTokens {
+	tokens := make([]types.ValidatorTokens, len(validators))
+	for index, validator := range validators {
+		tokens[index] = types.NewValidatorTokens(validator.OperatorAddress, validator.Tokens, validator.DelegatorShares)
+	}
+	return tokens
+}
+
+// updateProposalsValidatorStatusSnapshots updates the status snapshots of the given validators
+// for all the proposals that are open at the given time
+func (m *Module) updateProposalsValidatorStatusSnapshots(
+	height int64, timestamp time.Time, validators []stakingtypes.Validator,
+) error {
 	// get all active proposals IDs from db
-	ids, err := m.db.GetOpenProposalsIds(block.BlockTimestamp)
+	ids, err := m.db.GetOpenProposalsIds(timestamp)
 	if err != nil {
 		return fmt.Errorf("error while getting open proposals ids: %s", err)
 	}
 
-	// update validator status snapshots for all proposals IDs
+	// update validator status snapshot for all proposals IDs
 	// returned from database
 	for _, id := range ids {
 		// update validator status snapshot for given height and proposal ID
-		err = m.updateProposalValidatorStatusSnapshot(block.Height, id, validators)
+		err = m.updateProposalValidatorStatusSnapshot(height, id, validators)
 		if err != nil {
 			return fmt.Errorf("error while updating proposal validator status snapshots: %s", err)
 		}
@@ -270,10 +501,10 @@ func (m *Module) updateProposalValidatorStatusSnapshot(
 // updateValidatorStatusAndVP updates validators status
 // and validators voting power
 func (m *Module) updateValidatorStatusAndVP(height int64, validators []stakingtypes.Validator) error {
-	votingPowers := make([]types.ValidatorVotingPower, len(validators))
-	statuses := make([]types.ValidatorStatus, len(validators))
+	var votingPowers []types.ValidatorVotingPower
+	var statuses []types.ValidatorStatus
 
-	for index, validator := range validators {
+	for _, validator := range validators {
 		consAddr, err := validator.GetConsAddr()
 		if err != nil {
 			return err
@@ -288,15 +519,17 @@ func (m *Module) updateValidatorStatusAndVP(height int64, validators []stakingty
 			return err
 		}
 
-		votingPowers[index] = types.NewValidatorVotingPower(consAddr.String(), validator.Tokens.Int64(), height)
+		votingPowers = append(votingPowers,
+			types.NewValidatorVotingPower(consAddr.String(), validator.Tokens.Int64(), height),
+		)
 
-		statuses[index] = types.NewValidatorStatus(
+		statuses = append(statuses, types.NewValidatorStatus(
 			consAddr.String(),
 			consPubKey.String(),
 			int(validator.GetStatus()),
 			validator.IsJailed(),
 			height,
-		)
+		))
 	}
 
 	log.Debug().Str("module", "staking").Msg("refreshing validator voting power")
@@ -316,5 +549,18 @@ func (m *Module) updateValidatorStatusAndVP(height int64, validators []stakingty
 			Msg("error while saving validators statuses")
 	}
 
+	// Save the validators history
+	err = m.db.SaveValidatorsVotingPowersHistory(votingPowers)
+	if err != nil {
+		log.Error().Str("module", "staking").Err(err).Int64("height", height).
+			Msg("error while saving validators voting powers history")
+	}
+
+	err = m.db.SaveValidatorsStatusesHistory(statuses)
+	if err != nil {
+		log.Error().Str("module", "staking").Err(err).Int64("height", height).
+			Msg("error while saving validators statuses history")
+	}
+
 	return nil
 }
@@ -0,0 +1,166 @@
+package staking
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/stretchr/testify/require"
+
+	stakingsource "github.com/forbole/callisto/v4/modules/staking/source"
+)
+
+// fakeSource is a staking source that records the validators queries it receives
+type fakeSource struct {
+	stakingsource.Source
+
+	validators []stakingtypes.Validator
+	calls      []string
+	err        error
+}
+
+func (s *fakeSource) GetValidator(_ int64, valOper string) (stakingtypes.Validator, error) {
+	s.calls = append(s.calls, "GetValidator "+valOper)
+	if s.err != nil {
+		return stakingtypes.Validator{}, s.err
+	}
+	for _, validator := range s.validators {
+		if validator.OperatorAddress == valOper {
+			return validator, nil
+		}
+	}
+	return stakingtypes.Validator{OperatorAddress: valOper}, nil
+}
+
+func (s *fakeSource) GetValidatorsWithStatus(_ int64, _ string) ([]stakingtypes.Validator, error) {
+	s.calls = append(s.calls, "GetValidatorsWithStatus")
+	return s.validators, nil
+}
+
+// fakeValidatorsInfo is a validators info reader backed by an in-memory map
+type fakeValidatorsInfo struct {
+	operators map[string]string
+}
+
+func (i *fakeValidatorsInfo) HasValidatorsInfo() (bool, error) {
+	return len(i.operators) > 0, nil
+}
+
+func (i *fakeValidatorsInfo) FindValidatorOperatorAddress(consAddr string) (string, bool, error) {
+	valOper, found := i.operators[consAddr]
+	return valOper, found, nil
+}
+
+// newTestValidator returns a validator with a random consensus key
+func newTestValidator(t *testing.T) stakingtypes.Validator {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, stakingtypes.Description{})
+	require.NoError(t, err)
+	return validator
+}
+
+func TestModule_getUpdatedValidators(t *testing.T) {
+	const (
+		valOper1 = "cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl"
+		valOper2 = "cosmosvaloper1000ya26q2cmh399q4c5aaacd9lmmdqp90kw2jn"
+	)
+
+	source := &fakeSource{validators: []stakingtypes.Validator{
+		{OperatorAddress: valOper1},
+		{OperatorAddress: valOper2},
+	}}
+	cfg := DefaultConfig()
+	cfg.ReconciliationInterval = 10
+	info := &fakeValidatorsInfo{operators: map[string]string{"cosmosvalcons1": valOper1}}
+	m := &Module{cfg: cfg, source: source, validatorsInfo: info}
+
+	txs := []*juno.Tx{{TxResponse: &sdk.TxResponse{Events: []abci.Event{{
+		Type:       stakingtypes.EventTypeDelegate,
+		Attributes: []abci.EventAttribute{{Key: stakingtypes.AttributeKeyValidator, Value: valOper1}},
+	}}}}}
+
+	// Reconciliation height refreshes all the validators
+	validators, err := m.getUpdatedValidators(10, txs, &tmctypes.ResultBlockResults{})
+	require.NoError(t, err)
+	require.Equal(t, source.validators, validators)
+	require.Equal(t, []string{"GetValidatorsWithStatus"}, source.calls)
+
+	// Other heights refresh only the affected validators
+	source.calls = nil
+	validators, err = m.getUpdatedValidators(11, txs, &tmctypes.ResultBlockResults{})
+	require.NoError(t, err)
+	require.Equal(t, []stakingtypes.Validator{{OperatorAddress: valOper1}}, validators)
+	require.Equal(t, []string{"GetValidator " + valOper1}, source.calls)
+
+	// Blocks without events do not refresh any validator
+	source.calls = nil
+	validators, err = m.getUpdatedValidators(12, nil, &tmctypes.ResultBlockResults{})
+	require.NoError(t, err)
+	require.Empty(t, validators)
+	require.Empty(t, source.calls)
+}
+
+func TestModule_getUpdatedValidators_Reconciliation(t *testing.T) {
+	validator := newTestValidator(t)
+	source := &fakeSource{validators: []stakingtypes.Validator{validator}}
+	cfg := DefaultConfig()
+	cfg.ReconciliationInterval = 1000
+	info := &fakeValidatorsInfo{}
+	m := &Module{cfg: cfg, source: source, validatorsInfo: info}
+
+	// The first height processed refreshes all the validators
+	validators, err := m.getUpdatedValidators(11, nil, &tmctypes.ResultBlockResults{})
+	require.NoError(t, err)
+	require.Equal(t, source.validators, validators)
+	require.Equal(t, []string{"GetValidatorsWithStatus"}, source.calls)
+
+	// All the validators are refreshed as long as no validator info is stored
+	source.calls = nil
+	validators, err = m.getUpdatedValidators(12, nil, &tmctypes.ResultBlockResults{})
+	require.NoError(t, err)
+	require.Equal(t, source.validators, validators)
+	require.Equal(t, []string{"GetValidatorsWithStatus"}, source.calls)
+
+	// Once the info is stored, only the affected validators are refreshed
+	info.operators = map[string]string{"cosmosvalcons1": validator.OperatorAddress}
+	source.calls = nil
+	validators, err = m.getUpdatedValidators(13, nil, &tmctypes.ResultBlockResults{})
+	require.NoError(t, err)
+	require.Empty(t, validators)
+	require.Empty(t, source.calls)
+}
+
+func TestModule_getUpdatedValidators_MissingValidatorInfo(t *testing.T) {
+	stored, missing := newTestValidator(t), newTestValidator(t)
+	storedConsAddr, err := stored.GetConsAddr()
+	require.NoError(t, err)
+	missingConsAddr, err := missing.GetConsAddr()
+	require.NoError(t, err)
+
+	source := &fakeSource{validators: []stakingtypes.Validator{stored, missing}}
+	info := &fakeValidatorsInfo{operators: map[string]string{storedConsAddr.String(): stored.OperatorAddress}}
+	m := &Module{cfg: DefaultConfig(), source: source, validatorsInfo: info}
+	m.reconciled.Store(true)
+
+	slashEvent := func(consAddr sdk.ConsAddress) abci.Event {
+		return abci.Event{
+			Type:       slashingtypes.EventTypeSlash,
+			Attributes: []abci.EventAttribute{{Key: slashingtypes.AttributeKeyAddress, Value: consAddr.String()}},
+		}
+	}
+
+	// The validator without any stored info is resolved from the source using its consensus address
+	results := &tmctypes.ResultBlockResults{BeginBlockEvents: []abci.Event{
+		slashEvent(storedConsAddr),
+		slashEvent(missingConsAddr),
+	}}
+	validators, err := m.getUpdatedValidators(11, nil, results)
+	require.NoError(t, err)
+	require.Equal(t, []stakingtypes.Validator{stored, missing}, validators)
+	require.Equal(t, []string{"GetValidator " + stored.OperatorAddress, "GetValidatorsWithStatus"}, source.calls)
+}
@@ -9,8 +9,10 @@ import (
 	"github.com/forbole/juno/v5/node/remote"
 	"github.com/forbole/juno/v5/types/params"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -20,11 +22,17 @@ import (
 
 	nodeconfig "github.com/forbole/juno/v5/node/config"
 
+	authsource "github.com/forbole/callisto/v4/modules/auth/source"
+	localauthsource "github.com/forbole/callisto/v4/modules/auth/source/local"
+	remoteauthsource "github.com/forbole/callisto/v4/modules/auth/source/remote"
 	banksource "github.com/forbole/callisto/v4/modules/bank/source"
 	localbanksource "github.com/forbole/callisto/v4/modules/bank/source/local"
 	remotebanksource "github.com/forbole/callisto/v4/modules/bank/source/remote"
 	distrsource "github.com/forbole/callisto/v4/modules/distribution/source"
 	remotedistrsource "github.com/forbole/callisto/v4/modules/distribution/source/remote"
+	feegrantsource "github.com/forbole/callisto/v4/modules/feegrant/source"
+	localfeegrantsource "github.com/forbole/callisto/v4/modules/feegrant/source/local"
+	remotefeegrantsource "github.com/forbole/callisto/v4/modules/feegrant/source/remote"
 	govsource "github.com/forbole/callisto/v4/modules/gov/source"
 	localgovsource "github.com/forbole/callisto/v4/modules/gov/source/local"
 	remotegovsource "github.com/forbole/callisto/v4/modules/gov/source/remote"
@@ -40,8 +48,10 @@ import (
 )
 
 type Sources struct {
+	AuthSource     authsource.Source
 	BankSource     banksource.Source
 	DistrSource    distrsource.Source
+	FeegrantSource feegrantsource.Source
 	GovSource      govsource.Source
 	MintSource     mintsource.Source
 	SlashingSource slashingsource.Source
@@ -51,7 +61,7 @@ type Sources struct {
 func BuildSources(nodeCfg nodeconfig.Config, encodingConfig params.EncodingConfig) (*Sources, error) {
 	switch cfg := nodeCfg.Details.(type) {
 	case *remote.Details:
-		return buildRemoteSources(cfg)
+		return buildRemoteSources(cfg, encodingConfig)
 	case *local.Details:
 		return buildLocalSources(cfg, encodingConfig)
 
@@ -71,8 +81,10 @@ func buildLocalSources(cfg *local.Details, encodingConfig params.EncodingConfig)
 	)
 
 	sources := &Sources{
+		AuthSource: localauthsource.NewSource(source, authtypes.QueryServer(app.AccountKeeper), encodingConfig.Codec),
 		BankSource: localbanksource.NewSource(source, banktypes.QueryServer(app.BankKeeper)),
 		// DistrSource:    localdistrsource.NewSource(source, distrtypes.QueryServer(app.DistrKeeper)),
+		FeegrantSource: localfeegrantsource.NewSource(source, app.FeeGrantKeeper, encodingConfig.Codec),
 		GovSource:      localgovsource.NewSource(source, govtypesv1.QueryServer(app.GovKeeper)),
 		MintSource:     localmintsource.NewSource(source, minttypes.QueryServer(app.MintKeeper)),
 		SlashingSource: localslashingsource.NewSource(source, slashingtypes.QueryServer(app.SlashingKeeper)),
@@ -103,15 +115,17 @@ func buildLocalSources(cfg *local.Details, encodingConfig params.EncodingConfig)
 	return sources, nil
 }
 
-func buildRemoteSources(cfg *remote.Details) (*Sources, error) {
+func buildRemoteSources(cfg *remote.Details, encodingConfig params.EncodingConfig) (*Sources, error) {
 	source, err := remote.NewSource(cfg.GRPC)
 	if err != nil {
 		return nil, fmt.Errorf("error while creating remote source: %s", err)
 	}
 
 	return &Sources{
+		AuthSource:     remoteauthsource.NewSource(source, authtypes.NewQueryClient(source.GrpcConn), encodingConfig.Codec),
 		BankSource:     remotebanksource.NewSource(source, banktypes.NewQueryClient(source.GrpcConn)),
 		DistrSource:    remotedistrsource.NewSource(source, distrtypes.NewQueryClient(source.GrpcConn)),
+		FeegrantSource: remotefeegrantsource.NewSource(source, feegranttypes.NewQueryClient(source.GrpcConn), encodingConfig.Codec),
 		GovSource:      remotegovsource.NewSource(source, govtypesv1.NewQueryClient(source.GrpcConn)),
 		MintSource:     remotemintsource.NewSource(source, minttypes.NewQueryClient(source.GrpcConn)),
 		SlashingSource: remoteslashingsource.NewSource(source, slashingtypes.NewQueryClient(source.GrpcConn)),
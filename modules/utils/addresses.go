@@ -1,6 +1,10 @@
 package utils
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // FilterNonAccountAddresses filters all the non-account addresses from the given slice of addresses, returning a new
 // slice containing only account addresses.
@@ -15,3 +19,13 @@ func FilterNonAccountAddresses(addresses []string) []string {
 	}
 	return accountAddresses
 }
+
+// SortedKeys returns the keys of the given set of addresses, sorted alphabetically
+func SortedKeys(addresses map[string]bool) []string {
+	keys := make([]string, 0, len(addresses))
+	for address := range addresses {
+		keys = append(keys, address)
+	}
+	sort.Strings(keys)
+	return keys
+}
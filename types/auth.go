@@ -11,3 +11,35 @@ func NewAccount(address string) Account {
 		Address: address,
 	}
 }
+
+// AccountDetails contains the details of a chain account as returned by the auth module
+type AccountDetails struct {
+	Address           string
+	Type              string
+	PubKeyType        string
+	PubKey            []byte // JSON representation of the public key
+	AccountNumber     uint64
+	Sequence          uint64
+	ModuleName        string
+	ModulePermissions []string
+	Height            int64
+}
+
+// NewAccountDetails builds a new AccountDetails instance. If an account is only seen without being
+// associated with any on-chain data, all the values but the address and height should be empty
+func NewAccountDetails(
+	address string, accountType string, pubKeyType string, pubKey []byte, accountNumber uint64, sequence uint64,
+	moduleName string, modulePermissions []string, height int64,
+) AccountDetails {
+	return AccountDetails{
+		Address:           address,
+		Type:              accountType,
+		PubKeyType:        pubKeyType,
+		PubKey:            pubKey,
+		AccountNumber:     accountNumber,
+		Sequence:          sequence,
+		ModuleName:        moduleName,
+		ModulePermissions: modulePermissions,
+		Height:            height,
+	}
+}
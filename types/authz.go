@@ -0,0 +1,73 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// AuthzGrant represents an authorization granted using the x/authz module
+type AuthzGrant struct {
+	Granter       string
+	Grantee       string
+	Authorization authz.Authorization
+	Expiration    *time.Time
+	Height        int64
+}
+
+// NewAuthzGrant allows to build a new AuthzGrant instance
+func NewAuthzGrant(
+	granter string, grantee string, authorization authz.Authorization, expiration *time.Time, height int64,
+) AuthzGrant {
+	return AuthzGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+		Height:        height,
+	}
+}
+
+// AuthzGrantRemoval represents the removal of an authorization granted using the x/authz module
+type AuthzGrantRemoval struct {
+	Granter    string
+	Grantee    string
+	MsgTypeURL string
+	Height     int64
+}
+
+// NewAuthzGrantRemoval allows to build a new AuthzGrantRemoval instance
+func NewAuthzGrantRemoval(granter string, grantee string, msgTypeURL string, height int64) AuthzGrantRemoval {
+	return AuthzGrantRemoval{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeURL: msgTypeURL,
+		Height:     height,
+	}
+}
+
+// AuthzGrantUsage represents the usage of a grant to execute a message contained inside a MsgExec
+type AuthzGrantUsage struct {
+	TxHash           string
+	MsgIndex         int
+	ExecutedMsgIndex int
+	Granter          string
+	Grantee          string
+	MsgTypeURL       string
+	Height           int64
+}
+
+// NewAuthzGrantUsage allows to build a new AuthzGrantUsage instance
+func NewAuthzGrantUsage(
+	txHash string, msgIndex int, executedMsgIndex int, granter string, grantee string, msgTypeURL string, height int64,
+) AuthzGrantUsage {
+	return AuthzGrantUsage{
+		TxHash:           txHash,
+		MsgIndex:         msgIndex,
+		ExecutedMsgIndex: executedMsgIndex,
+		Granter:          granter,
+		Grantee:          grantee,
+		MsgTypeURL:       msgTypeURL,
+		Height:           height,
+	}
+}
@@ -17,3 +17,29 @@ func NewAccountBalance(address string, balance sdk.Coins, height int64) AccountB
 		Height:  height,
 	}
 }
+
+// TokenTransfer represents a single transfer of tokens from one account to another one
+type TokenTransfer struct {
+	TxHash      string
+	MsgIndex    int
+	LegIndex    int
+	FromAddress string
+	ToAddress   string
+	Amount      sdk.Coins
+	Height      int64
+}
+
+// NewTokenTransfer allows to build a new TokenTransfer instance
+func NewTokenTransfer(
+	txHash string, msgIndex int, legIndex int, fromAddress string, toAddress string, amount sdk.Coins, height int64,
+) TokenTransfer {
+	return TokenTransfer{
+		TxHash:      txHash,
+		MsgIndex:    msgIndex,
+		LegIndex:    legIndex,
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		Amount:      amount,
+		Height:      height,
+	}
+}
@@ -7,19 +7,34 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/forbole/callisto/v4/modules/actions"
+	"github.com/forbole/callisto/v4/modules/bank"
+	"github.com/forbole/callisto/v4/modules/scoring"
+	"github.com/forbole/callisto/v4/modules/slashing"
+	"github.com/forbole/callisto/v4/modules/staking"
 )
 
 // Config represents the Callisto configuration
 type Config struct {
-	JunoConfig    junoconfig.Config `yaml:"-,inline"`
-	ActionsConfig *actions.Config   `yaml:"actions"`
+	JunoConfig     junoconfig.Config `yaml:"-,inline"`
+	ActionsConfig  *actions.Config   `yaml:"actions"`
+	BankConfig     *bank.Config      `yaml:"bank"`
+	StakingConfig  *staking.Config   `yaml:"staking"`
+	ScoringConfig  *scoring.Config   `yaml:"scoring"`
+	SlashingConfig *slashing.Config  `yaml:"slashing"`
 }
 
 // NewConfig returns a new Config instance
-func NewConfig(junoCfg junoconfig.Config, actionsCfg *actions.Config) Config {
+func NewConfig(
+	junoCfg junoconfig.Config, actionsCfg *actions.Config, bankCfg *bank.Config,
+	stakingCfg *staking.Config, scoringCfg *scoring.Config, slashingCfg *slashing.Config,
+) Config {
 	return Config{
-		JunoConfig:    junoCfg,
-		ActionsConfig: actionsCfg,
+		JunoConfig:     junoCfg,
+		ActionsConfig:  actionsCfg,
+		BankConfig:     bankCfg,
+		StakingConfig:  stakingCfg,
+		ScoringConfig:  scoringCfg,
+		SlashingConfig: slashingCfg,
 	}
 }
 
@@ -30,5 +45,12 @@ func (c Config) GetBytes() ([]byte, error) {
 
 // Creator represents a configuration creator
 func Creator(_ *cobra.Command) initcmd.WritableConfig {
-	return NewConfig(junoconfig.DefaultConfig(), actions.DefaultConfig())
+	return NewConfig(
+		junoconfig.DefaultConfig(),
+		actions.DefaultConfig(),
+		bank.DefaultConfig(),
+		staking.DefaultConfig(),
+		scoring.DefaultConfig(),
+		slashing.DefaultConfig(),
+	)
 }
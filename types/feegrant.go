@@ -1,6 +1,9 @@
 package types
 
-import feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+)
 
 // FeeGrant represents the x/feegrant module
 type FeeGrant struct {
@@ -16,17 +19,58 @@ func NewFeeGrant(feegrant feegranttypes.Grant, height int64) FeeGrant {
 	}
 }
 
+// FeeGrantStatus represents the status a fee grant allowance has transitioned to
+type FeeGrantStatus string
+
+const (
+	// FeeGrantStatusGranted is used when an allowance has been granted
+	FeeGrantStatusGranted FeeGrantStatus = "granted"
+
+	// FeeGrantStatusRevoked is used when an allowance has been revoked by its granter
+	FeeGrantStatusRevoked FeeGrantStatus = "revoked"
+
+	// FeeGrantStatusExpired is used when an allowance has been removed after its expiration
+	FeeGrantStatusExpired FeeGrantStatus = "expired"
+
+	// FeeGrantStatusExhausted is used when an allowance has been removed after its spend limit has been used
+	FeeGrantStatusExhausted FeeGrantStatus = "exhausted"
+)
+
 type GrantRemoval struct {
 	Grantee string
 	Granter string
+	Status  FeeGrantStatus
+	TxHash  string
 	Height  int64
 }
 
 // NewGrantRemoval allows to build a new GrantRemoval instance
-func NewGrantRemoval(grantee string, granter string, height int64) GrantRemoval {
+func NewGrantRemoval(grantee string, granter string, status FeeGrantStatus, txHash string, height int64) GrantRemoval {
 	return GrantRemoval{
 		grantee,
 		granter,
+		status,
+		txHash,
 		height,
 	}
 }
+
+// FeeGrantUsage represents the fees paid by a fee grant allowance inside a transaction
+type FeeGrantUsage struct {
+	TxHash  string
+	Grantee string
+	Granter string
+	Fee     sdk.Coins
+	Height  int64
+}
+
+// NewFeeGrantUsage allows to build a new FeeGrantUsage instance
+func NewFeeGrantUsage(txHash string, grantee string, granter string, fee sdk.Coins, height int64) FeeGrantUsage {
+	return FeeGrantUsage{
+		TxHash:  txHash,
+		Grantee: grantee,
+		Granter: granter,
+		Fee:     fee,
+		Height:  height,
+	}
+}
@@ -1,11 +1,17 @@
 package types
 
-import "time"
+import (
+	"time"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
 
 // Token represents a valid token inside the chain
 type Token struct {
-	Name  string      `yaml:"name"`
-	Units []TokenUnit `yaml:"units"`
+	Name        string      `yaml:"name"`
+	DisplayName string      `yaml:"display_name,omitempty"`
+	Symbol      string      `yaml:"symbol,omitempty"`
+	Units       []TokenUnit `yaml:"units"`
 }
 
 func NewToken(name string, units []TokenUnit) Token {
@@ -15,6 +21,22 @@ func NewToken(name string, units []TokenUnit) Token {
 	}
 }
 
+// NewTokenFromMetadata builds a new Token instance from the given bank denom metadata.
+// The base denom is used as the token name, since it is the only value guaranteed to be unique
+func NewTokenFromMetadata(metadata banktypes.Metadata) Token {
+	units := make([]TokenUnit, len(metadata.DenomUnits))
+	for i, unit := range metadata.DenomUnits {
+		units[i] = NewTokenUnit(unit.Denom, int(unit.Exponent), unit.Aliases, "")
+	}
+
+	return Token{
+		Name:        metadata.Base,
+		DisplayName: metadata.Name,
+		Symbol:      metadata.Symbol,
+		Units:       units,
+	}
+}
+
 // TokenUnit represents a unit of a token
 type TokenUnit struct {
 	Denom    string   `yaml:"denom"`
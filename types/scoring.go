@@ -0,0 +1,28 @@
+package types
+
+// ValidatorScoreWeights contains the weights used to combine the rating criteria of a validator into its score
+type ValidatorScoreWeights struct {
+	SelfDelegation   float64 `yaml:"self_delegation"`
+	Uptime           float64 `yaml:"uptime"`
+	NeverSlashed     float64 `yaml:"never_slashed"`
+	GovParticipation float64 `yaml:"gov_participation"`
+	Delegators       float64 `yaml:"delegators"`
+}
+
+// NewValidatorScoreWeights allows to build a new ValidatorScoreWeights instance
+func NewValidatorScoreWeights(
+	selfDelegation, uptime, neverSlashed, govParticipation, delegators float64,
+) ValidatorScoreWeights {
+	return ValidatorScoreWeights{
+		SelfDelegation:   selfDelegation,
+		Uptime:           uptime,
+		NeverSlashed:     neverSlashed,
+		GovParticipation: govParticipation,
+		Delegators:       delegators,
+	}
+}
+
+// Total returns the sum of all the weights
+func (w ValidatorScoreWeights) Total() float64 {
+	return w.SelfDelegation + w.Uptime + w.NeverSlashed + w.GovParticipation + w.Delegators
+}
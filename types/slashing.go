@@ -3,6 +3,7 @@ package types
 import (
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
@@ -64,3 +65,82 @@ func NewSlashingParams(params slashingtypes.Params, height int64) *SlashingParam
 		Height: height,
 	}
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// UptimePeriod represents the period over which the uptime of a validator is computed
+type UptimePeriod string
+
+const (
+	// UptimePeriodSignedBlocksWindow represents the signed blocks window of the slashing module
+	UptimePeriodSignedBlocksWindow UptimePeriod = "signed_blocks_window"
+	UptimePeriodHour               UptimePeriod = "1h"
+	UptimePeriodDay                UptimePeriod = "24h"
+	UptimePeriodWeek               UptimePeriod = "7d"
+)
+
+// GetDuration returns the duration of the period, or 0 if the period is not time based
+func (p UptimePeriod) GetDuration() time.Duration {
+	switch p {
+	case UptimePeriodHour:
+		return time.Hour
+	case UptimePeriodDay:
+		return 24 * time.Hour
+	case UptimePeriodWeek:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidatorSlash represents a slash applied to a validator at a given height
+type ValidatorSlash struct {
+	ValidatorAddress string
+	Power            int64
+	Reason           string
+	BurnedTokens     sdkmath.Int
+	Jailed           bool
+	Height           int64
+}
+
+// NewValidatorSlash allows to build a new ValidatorSlash instance
+func NewValidatorSlash(
+	validatorAddress string, power int64, reason string, burnedTokens sdkmath.Int, jailed bool, height int64,
+) ValidatorSlash {
+	return ValidatorSlash{
+		ValidatorAddress: validatorAddress,
+		Power:            power,
+		Reason:           reason,
+		BurnedTokens:     burnedTokens,
+		Jailed:           jailed,
+		Height:           height,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidatorJailEvent represents the jailing of a validator at a given height
+type ValidatorJailEvent struct {
+	ValidatorAddress string
+	Reason           string
+	JailedHeight     int64
+	JailedAt         time.Time
+	JailedUntil      *time.Time
+	Tombstoned       bool
+}
+
+// NewValidatorJailEvent allows to build a new ValidatorJailEvent instance
+func NewValidatorJailEvent(
+	validatorAddress string, reason string, jailedHeight int64, jailedAt time.Time, jailedUntil *time.Time, tombstoned bool,
+) ValidatorJailEvent {
+	return ValidatorJailEvent{
+		ValidatorAddress: validatorAddress,
+		Reason:           reason,
+		JailedHeight:     jailedHeight,
+		JailedAt:         jailedAt,
+		JailedUntil:      jailedUntil,
+		Tombstoned:       tombstoned,
+	}
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Delegation represents a single delegation made by an account to a validator
+type Delegation struct {
+	DelegatorAddress string
+	ValidatorAddress string
+	Shares           sdk.Dec
+	Amount           sdk.Coin
+	Height           int64
+}
+
+// NewDelegation allows to build a new Delegation instance.
+// The validatorAddress must be the consensus address of the validator
+func NewDelegation(
+	delegatorAddress string, validatorAddress string, shares sdk.Dec, amount sdk.Coin, height int64,
+) Delegation {
+	return Delegation{
+		DelegatorAddress: delegatorAddress,
+		ValidatorAddress: validatorAddress,
+		Shares:           shares,
+		Amount:           amount,
+		Height:           height,
+	}
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// UnbondingDelegationEntry represents a single entry of an unbonding delegation
+type UnbondingDelegationEntry struct {
+	DelegatorAddress string
+	ValidatorAddress string
+	CreationHeight   int64
+	CompletionTime   time.Time
+	InitialBalance   sdkmath.Int
+	Balance          sdkmath.Int
+	Height           int64
+}
+
+// NewUnbondingDelegationEntry allows to build a new UnbondingDelegationEntry instance.
+// The validatorAddress must be the consensus address of the validator
+func NewUnbondingDelegationEntry(
+	delegatorAddress string, validatorAddress string,
+	creationHeight int64, completionTime time.Time, initialBalance sdkmath.Int, balance sdkmath.Int,
+	height int64,
+) UnbondingDelegationEntry {
+	return UnbondingDelegationEntry{
+		DelegatorAddress: delegatorAddress,
+		ValidatorAddress: validatorAddress,
+		CreationHeight:   creationHeight,
+		CompletionTime:   completionTime,
+		InitialBalance:   initialBalance,
+		Balance:          balance,
+		Height:           height,
+	}
+}
+
+// RedelegationEntry represents a single entry of a redelegation
+type RedelegationEntry struct {
+	DelegatorAddress    string
+	SrcValidatorAddress string
+	DstValidatorAddress string
+	CreationHeight      int64
+	CompletionTime      time.Time
+	InitialBalance      sdkmath.Int
+	Balance             sdkmath.Int
+	Height              int64
+}
+
+// NewRedelegationEntry allows to build a new RedelegationEntry instance.
+// The srcValidatorAddress and dstValidatorAddress must be the consensus addresses of the validators
+func NewRedelegationEntry(
+	delegatorAddress string, srcValidatorAddress string, dstValidatorAddress string,
+	creationHeight int64, completionTime time.Time, initialBalance sdkmath.Int, balance sdkmath.Int,
+	height int64,
+) RedelegationEntry {
+	return RedelegationEntry{
+		DelegatorAddress:    delegatorAddress,
+		SrcValidatorAddress: srcValidatorAddress,
+		DstValidatorAddress: dstValidatorAddress,
+		CreationHeight:      creationHeight,
+		CompletionTime:      completionTime,
+		InitialBalance:      initialBalance,
+		Balance:             balance,
+		Height:              height,
+	}
+}
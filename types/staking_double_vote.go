@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 // DoubleSignEvidence represent a double sign evidence on each tendermint block
 type DoubleSignEvidence struct {
 	VoteA  DoubleSignVote
@@ -47,3 +49,55 @@ func NewDoubleSignVote(
 		Signature:        signature,
 	}
 }
+
+// LightClientAttackEvidence represents a light client attack evidence included inside a tendermint block
+type LightClientAttackEvidence struct {
+	Hash                   string
+	ConflictingBlockHeight int64
+	ConflictingBlockHash   string
+	CommonHeight           int64
+	ByzantineValidators    []ByzantineValidator
+	TotalVotingPower       int64
+	Timestamp              time.Time
+	Height                 int64
+}
+
+// NewLightClientAttackEvidence returns a new LightClientAttackEvidence instance
+func NewLightClientAttackEvidence(
+	hash string,
+	conflictingBlockHeight int64,
+	conflictingBlockHash string,
+	commonHeight int64,
+	byzantineValidators []ByzantineValidator,
+	totalVotingPower int64,
+	timestamp time.Time,
+	height int64,
+) LightClientAttackEvidence {
+	return LightClientAttackEvidence{
+		Hash:                   hash,
+		ConflictingBlockHeight: conflictingBlockHeight,
+		ConflictingBlockHash:   conflictingBlockHash,
+		CommonHeight:           commonHeight,
+		ByzantineValidators:    byzantineValidators,
+		TotalVotingPower:       totalVotingPower,
+		Timestamp:              timestamp,
+		Height:                 height,
+	}
+}
+
+// ByzantineValidator represents a validator that misbehaved in creating the conflicting block
+// of a LightClientAttackEvidence
+type ByzantineValidator struct {
+	ConsensusAddress string
+	ConsensusPubKey  string
+	VotingPower      int64
+}
+
+// NewByzantineValidator returns a new ByzantineValidator instance
+func NewByzantineValidator(consAddr string, consPubKey string, votingPower int64) ByzantineValidator {
+	return ByzantineValidator{
+		ConsensusAddress: consAddr,
+		ConsensusPubKey:  consPubKey,
+		VotingPower:      votingPower,
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -101,6 +103,26 @@ func NewValidatorDescription(
 
 // ----------------------------------------------------------------------------------------------------------
 
+// ValidatorAvatar contains the avatar URL resolved from a validator identity
+type ValidatorAvatar struct {
+	Identity  string
+	AvatarURL string
+	Resolver  string // Name of the resolver that found the avatar. Empty if no avatar has been found
+	UpdatedAt time.Time
+}
+
+// NewValidatorAvatar returns a new ValidatorAvatar instance
+func NewValidatorAvatar(identity string, avatarURL string, resolver string, updatedAt time.Time) ValidatorAvatar {
+	return ValidatorAvatar{
+		Identity:  identity,
+		AvatarURL: avatarURL,
+		Resolver:  resolver,
+		UpdatedAt: updatedAt,
+	}
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
 // ValidatorCommission contains the data of a validator commission at a given height
 type ValidatorCommission struct {
 	ValAddress        string
@@ -161,4 +183,23 @@ func NewValidatorStatus(valConsAddr, pubKey string, status int, jailed bool, hei
 	}
 }
 
+//--------------------------------------------------------
+
+// ValidatorTokens represents the tokens and delegator shares of a validator,
+// which allow to convert its delegations shares into tokens
+type ValidatorTokens struct {
+	OperatorAddress string
+	Tokens          sdk.Int
+	DelegatorShares sdk.Dec
+}
+
+// NewValidatorTokens creates a new ValidatorTokens
+func NewValidatorTokens(operatorAddress string, tokens sdk.Int, delegatorShares sdk.Dec) ValidatorTokens {
+	return ValidatorTokens{
+		OperatorAddress: operatorAddress,
+		Tokens:          tokens,
+		DelegatorShares: delegatorShares,
+	}
+}
+
 //---------------------------------------------------------------
@@ -0,0 +1,34 @@
+package types
+
+import "time"
+
+// ValidatorSetChangeType represents the type of change of a validator inside the validator set
+type ValidatorSetChangeType string
+
+const (
+	ValidatorSetChangeJoined     ValidatorSetChangeType = "joined"
+	ValidatorSetChangeLeft       ValidatorSetChangeType = "left"
+	ValidatorSetChangeJailed     ValidatorSetChangeType = "jailed"
+	ValidatorSetChangeUnjailed   ValidatorSetChangeType = "unjailed"
+	ValidatorSetChangeTombstoned ValidatorSetChangeType = "tombstoned"
+)
+
+// ValidatorSetChange represents a change of a validator inside the validator set at a given height
+type ValidatorSetChange struct {
+	ValidatorAddress string
+	Type             ValidatorSetChangeType
+	Height           int64
+	Timestamp        time.Time
+}
+
+// NewValidatorSetChange allows to build a new ValidatorSetChange instance
+func NewValidatorSetChange(
+	validatorAddress string, changeType ValidatorSetChangeType, height int64, timestamp time.Time,
+) ValidatorSetChange {
+	return ValidatorSetChange{
+		ValidatorAddress: validatorAddress,
+		Type:             changeType,
+		Height:           height,
+		Timestamp:        timestamp,
+	}
+}
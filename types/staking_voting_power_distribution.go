@@ -0,0 +1,97 @@
+package types
+
+import (
+	"sort"
+	"time"
+)
+
+// VotingPowerDistribution contains the metrics about how the voting power is distributed among the active validators
+type VotingPowerDistribution struct {
+	ValidatorsCount  int
+	TotalVotingPower int64
+
+	// NakamotoCoefficient is the minimum number of validators that together control more than
+	// one third of the voting power, and can therefore halt the chain
+	NakamotoCoefficient int
+
+	// GiniCoefficient measures the inequality of the voting power distribution,
+	// from 0 (all validators have the same voting power) towards 1 (a single validator has all the voting power)
+	GiniCoefficient float64
+
+	// TopNShare is the share of the voting power controlled by the TopN validators having the most voting power
+	TopN      int
+	TopNShare float64
+
+	Shares    []ValidatorVotingPowerShare
+	Timestamp time.Time
+	Height    int64
+}
+
+// ValidatorVotingPowerShare contains the share of the total voting power controlled by a single validator
+type ValidatorVotingPowerShare struct {
+	ValidatorAddress string
+	VotingPower      int64
+	Share            float64
+	Rank             int
+}
+
+// NewVotingPowerDistribution computes the VotingPowerDistribution of the given voting powers,
+// considering the topN validators having the most voting power for the concentration metric
+func NewVotingPowerDistribution(
+	votingPowers map[string]int64, topN int, timestamp time.Time, height int64,
+) VotingPowerDistribution {
+	shares := make([]ValidatorVotingPowerShare, 0, len(votingPowers))
+	var total int64
+	for address, votingPower := range votingPowers {
+		shares = append(shares, ValidatorVotingPowerShare{ValidatorAddress: address, VotingPower: votingPower})
+		total += votingPower
+	}
+
+	// Sort the validators by descending voting power, using the address to have a deterministic order
+	sort.Slice(shares, func(i, j int) bool {
+		if shares[i].VotingPower != shares[j].VotingPower {
+			return shares[i].VotingPower > shares[j].VotingPower
+		}
+		return shares[i].ValidatorAddress < shares[j].ValidatorAddress
+	})
+
+	distribution := VotingPowerDistribution{
+		ValidatorsCount:  len(shares),
+		TotalVotingPower: total,
+		TopN:             topN,
+		Shares:           shares,
+		Timestamp:        timestamp,
+		Height:           height,
+	}
+
+	if total == 0 {
+		for i := range shares {
+			shares[i].Rank = i + 1
+		}
+		return distribution
+	}
+
+	var cumulative, topNPower, weightedSum int64
+	for i := range shares {
+		shares[i].Rank = i + 1
+		shares[i].Share = float64(shares[i].VotingPower) / float64(total)
+
+		cumulative += shares[i].VotingPower
+		if distribution.NakamotoCoefficient == 0 && 3*cumulative > total {
+			distribution.NakamotoCoefficient = i + 1
+		}
+
+		if i < topN {
+			topNPower += shares[i].VotingPower
+		}
+
+		// The Gini coefficient formula requires the values in ascending order, hence the reversed index
+		weightedSum += int64(len(shares)-i) * shares[i].VotingPower
+	}
+
+	n := float64(len(shares))
+	distribution.TopNShare = float64(topNPower) / float64(total)
+	distribution.GiniCoefficient = 2*float64(weightedSum)/(n*float64(total)) - (n+1)/n
+
+	return distribution
+}